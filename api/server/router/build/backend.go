@@ -5,10 +5,13 @@ import (
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/backend"
+	"github.com/docker/docker/api/types/filters"
 	"golang.org/x/net/context"
 )
 
 // Backend abstracts an image builder whose only purpose is to build an image referenced by an imageID.
+// It is the full set of methods the build router needs, and is implemented
+// by the daemon's dockerfile.BuildManager.
 type Backend interface {
 	// Build builds a Docker image referenced by an imageID string.
 	//
@@ -17,4 +20,14 @@ type Backend interface {
 	//
 	// TODO: make this return a reference instead of string
 	BuildFromContext(ctx context.Context, src io.ReadCloser, remote string, buildOptions *types.ImageBuildOptions, pg backend.ProgressWriter) (string, error)
+
+	// CancelBuild cancels the in-progress build with the given build ID.
+	CancelBuild(id string) error
+
+	// PruneBuildCache removes the build cache and the build containers
+	// that are no longer used.
+	PruneBuildCache(pruneFilters filters.Args) (*types.BuildCachePruneReport, error)
+
+	// BuildStatus returns the state of the build with the given build ID.
+	BuildStatus(id string) (*types.BuildStatus, error)
 }
@@ -0,0 +1,6 @@
+package build
+
+import "github.com/docker/docker/builder/dockerfile"
+
+// The daemon's build manager must provide everything the router needs.
+var _ Backend = &dockerfile.BuildManager{}
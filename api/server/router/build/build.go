@@ -12,13 +12,19 @@ import  (
 type buildRouter struct {
 	backend Backend
 	routes  []router.Route
+
+	// maxContextSize is the maximum size in bytes of a build context
+	// sent by the client, or 0 for no limit.
+	maxContextSize int64
 }
 
-// NewRouter initializes a new build router
-func NewRouter(b Backend) router.Router {
+// NewRouter initializes a new build router. Build contexts larger than
+// maxContextSize bytes are rejected, unless maxContextSize is 0.
+func NewRouter(b Backend, maxContextSize int64) router.Router {
     fmt.Println("api/server/router/build/build.go  NewRouter()")
 	r := &buildRouter{
-		backend: b,
+		backend:        b,
+		maxContextSize: maxContextSize,
 	}
 	r.initRoutes()
 	return r
@@ -32,6 +38,9 @@ func (r *buildRouter) Routes() []router.Route {
 
 func (r *buildRouter) initRoutes() {
 	r.routes = []router.Route{
+		router.NewGetRoute("/build/{id:.*}", r.getBuildStatus),
 		router.Cancellable(router.NewPostRoute("/build", r.postBuild)),
+		router.NewPostRoute("/build/prune", r.postBuildPrune),
+		router.NewPostRoute("/build/{id:.*}/cancel", r.postBuildCancel),
 	}
 }
@@ -13,14 +13,18 @@ import (
 	"sync"
 
 	"github.com/Sirupsen/logrus"
+	apierrors "github.com/docker/docker/api/errors"
 	"github.com/docker/docker/api/server/httputils"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/backend"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/versions"
 	"github.com/docker/docker/pkg/ioutils"
+	"github.com/docker/docker/pkg/jsonmessage"
 	"github.com/docker/docker/pkg/progress"
 	"github.com/docker/docker/pkg/streamformatter"
+	"github.com/docker/docker/pkg/urlutil"
 	"github.com/docker/go-units"
 	"golang.org/x/net/context"
 )
@@ -55,6 +59,7 @@ func newImageBuildOptions(ctx context.Context, r *http.Request) (*types.ImageBui
 	options.Tags = r.Form["t"]
 	options.SecurityOpt = r.Form["securityopt"]
 	options.Squash = httputils.BoolValue(r, "squash")
+	options.BuildID = r.FormValue("buildid")
 
 	if r.Form.Get("shmsize") != "" {
 		shmSize, err := strconv.ParseInt(r.Form.Get("shmsize"), 10, 64)
@@ -127,6 +132,47 @@ func newImageBuildOptions(ctx context.Context, r *http.Request) (*types.ImageBui
 	return options, nil
 }
 
+// validateRemoteURL checks that a remote build context, which the daemon
+// fetches instead of reading the request body, is a git, http or https URL.
+func validateRemoteURL(remoteURL string) error {
+	if remoteURL == "" || urlutil.IsGitURL(remoteURL) || urlutil.IsURL(remoteURL) {
+		return nil
+	}
+	return apierrors.NewBadRequestError(fmt.Errorf("unsupported remote build context %q: only git, http and https URLs are supported", remoteURL))
+}
+
+// limitedBody fails reads once more than limit bytes have been read from
+// the request body.
+type limitedBody struct {
+	io.Closer
+	r        io.Reader
+	limit    int64
+	n        int64
+	exceeded bool
+}
+
+func newLimitedBody(body io.ReadCloser, limit int64) *limitedBody {
+	return &limitedBody{
+		Closer: body,
+		r:      io.LimitReader(body, limit+1),
+		limit:  limit,
+	}
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.n += int64(n)
+	if l.n > l.limit {
+		l.exceeded = true
+		return 0, errContextTooLarge(l.limit)
+	}
+	return n, err
+}
+
+func errContextTooLarge(limit int64) error {
+	return apierrors.NewErrorWithStatusCode(fmt.Errorf("build context exceeds the maximum size of %d bytes", limit), http.StatusRequestEntityTooLarge)
+}
+
 type syncWriter struct {
 	w  io.Writer
 	mu sync.Mutex
@@ -183,6 +229,9 @@ func (br *buildRouter) postBuild(ctx context.Context, w http.ResponseWriter, r *
 	buildOptions.AuthConfigs = authConfigs
 
 	remoteURL := r.FormValue("remote")
+	if err := validateRemoteURL(remoteURL); err != nil {
+		return errf(err)
+	}
 
 	// Currently, only used if context is from a remote url.
 	// Look at code in DetectContextFromRemoteURL for more information.
@@ -207,9 +256,23 @@ func (br *buildRouter) postBuild(ctx context.Context, w http.ResponseWriter, r *
 		StdoutFormatter:    stdout,
 		StderrFormatter:    stderr,
 		ProgressReaderFunc: createProgressReader,
+		BuildProgressFunc:  newBuildProgressFunc(out),
 	}
 
-	imgID, err := br.backend.BuildFromContext(ctx, r.Body, remoteURL, buildOptions, pg)
+	body := r.Body
+	var limited *limitedBody
+	if br.maxContextSize > 0 {
+		if r.ContentLength > br.maxContextSize {
+			return errf(errContextTooLarge(br.maxContextSize))
+		}
+		limited = newLimitedBody(r.Body, br.maxContextSize)
+		body = limited
+	}
+
+	imgID, err := br.backend.BuildFromContext(ctx, body, remoteURL, buildOptions, pg)
+	if limited != nil && limited.exceeded {
+		return errf(errContextTooLarge(br.maxContextSize))
+	}
 	if err != nil {
 		return errf(err)
 	}
@@ -223,3 +286,56 @@ func (br *buildRouter) postBuild(ctx context.Context, w http.ResponseWriter, r *
 
 	return nil
 }
+
+// newBuildProgressFunc returns a function writing each build progress
+// message to out as the aux field of a JSON message, which clients that do
+// not know about build progress skip. The output is flushed after every
+// message when out wraps the response writer.
+func newBuildProgressFunc(out io.Writer) func(backend.BuildProgress) error {
+	return func(p backend.BuildProgress) error {
+		aux, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		raw := json.RawMessage(aux)
+		b, err := json.Marshal(&jsonmessage.JSONMessage{Aux: &raw})
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(append(b, '\n'))
+		return err
+	}
+}
+
+func (br *buildRouter) getBuildStatus(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	status, err := br.backend.BuildStatus(vars["id"])
+	if err != nil {
+		return err
+	}
+	return httputils.WriteJSON(w, http.StatusOK, status)
+}
+
+func (br *buildRouter) postBuildCancel(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := br.backend.CancelBuild(vars["id"]); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (br *buildRouter) postBuildPrune(ctx context.Context, w http.ResponseWriter, r *http.Request, vars map[string]string) error {
+	if err := httputils.ParseForm(r); err != nil {
+		return err
+	}
+
+	pruneFilters, err := filters.FromParam(r.Form.Get("filters"))
+	if err != nil {
+		return err
+	}
+
+	pruneReport, err := br.backend.PruneBuildCache(pruneFilters)
+	if err != nil {
+		return err
+	}
+	return httputils.WriteJSON(w, http.StatusOK, pruneReport)
+}
@@ -0,0 +1,291 @@
+package build
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "github.com/docker/docker/api/errors"
+	"github.com/docker/docker/api/server/httputils"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/backend"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"golang.org/x/net/context"
+)
+
+type fakeBackend struct {
+	build        func(src io.ReadCloser, pg backend.ProgressWriter) (string, error)
+	builds       map[string]bool
+	cancelled    []string
+	pruneFilters *filters.Args
+	statuses     map[string]*types.BuildStatus
+	remote       string
+}
+
+func (b *fakeBackend) BuildFromContext(ctx context.Context, src io.ReadCloser, remote string, buildOptions *types.ImageBuildOptions, pg backend.ProgressWriter) (string, error) {
+	b.remote = remote
+	if b.build == nil {
+		return "", nil
+	}
+	return b.build(src, pg)
+}
+
+func (b *fakeBackend) CancelBuild(id string) error {
+	if !b.builds[id] {
+		return apierrors.NewRequestNotFoundError(fmt.Errorf("no such build: %s", id))
+	}
+	b.cancelled = append(b.cancelled, id)
+	return nil
+}
+
+func (b *fakeBackend) PruneBuildCache(pruneFilters filters.Args) (*types.BuildCachePruneReport, error) {
+	b.pruneFilters = &pruneFilters
+	return &types.BuildCachePruneReport{
+		CachesDeleted:  []string{"c1", "c2"},
+		SpaceReclaimed: 42,
+	}, nil
+}
+
+func (b *fakeBackend) BuildStatus(id string) (*types.BuildStatus, error) {
+	status, ok := b.statuses[id]
+	if !ok {
+		return nil, apierrors.NewRequestNotFoundError(fmt.Errorf("no such build: %s", id))
+	}
+	return status, nil
+}
+
+func TestPostBuildCancel(t *testing.T) {
+	b := &fakeBackend{builds: map[string]bool{"build1": true}}
+	r := &buildRouter{backend: b}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/build/build1/cancel", nil)
+	if err := r.postBuildCancel(context.Background(), w, req, map[string]string{"id": "build1"}); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+	if len(b.cancelled) != 1 || b.cancelled[0] != "build1" {
+		t.Fatalf("expected build1 to be cancelled, got %v", b.cancelled)
+	}
+}
+
+func TestPostBuildCancelNotFound(t *testing.T) {
+	r := &buildRouter{backend: &fakeBackend{}}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/build/unknown/cancel", nil)
+	err := r.postBuildCancel(context.Background(), w, req, map[string]string{"id": "unknown"})
+	if err == nil {
+		t.Fatal("expected an error for an unknown build")
+	}
+	if status := httputils.GetHTTPErrorStatusCode(err); status != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, status)
+	}
+}
+
+func TestPostBuildPrune(t *testing.T) {
+	b := &fakeBackend{}
+	r := &buildRouter{backend: b}
+
+	form := url.Values{"filters": {`{"label":{"foo=bar":true}}`}}
+	req := httptest.NewRequest("POST", "/build/prune", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	if err := r.postBuildPrune(context.Background(), w, req, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if b.pruneFilters == nil {
+		t.Fatal("expected the backend to be called")
+	}
+	if !b.pruneFilters.ExactMatch("label", "foo=bar") {
+		t.Fatalf("expected the label filter to be passed, got %v", *b.pruneFilters)
+	}
+
+	var report map[string]interface{}
+	if err := json.NewDecoder(w.Body).Decode(&report); err != nil {
+		t.Fatal(err)
+	}
+	if len(report) != 2 {
+		t.Fatalf("expected CachesDeleted and SpaceReclaimed, got %v", report)
+	}
+	if deleted, ok := report["CachesDeleted"].([]interface{}); !ok || len(deleted) != 2 {
+		t.Fatalf("expected 2 deleted caches, got %v", report["CachesDeleted"])
+	}
+	if report["SpaceReclaimed"] != float64(42) {
+		t.Fatalf("expected 42 bytes reclaimed, got %v", report["SpaceReclaimed"])
+	}
+}
+
+func TestPostBuildStreamsProgress(t *testing.T) {
+	w := httptest.NewRecorder()
+	events := []backend.BuildProgress{
+		{StepID: "1", Status: "running"},
+		{StepID: "1", Status: "done", Stream: "abc"},
+		{StepID: "2", Error: "failed"},
+	}
+	b := &fakeBackend{
+		build: func(src io.ReadCloser, pg backend.ProgressWriter) (string, error) {
+			for i, e := range events {
+				if err := pg.BuildProgressFunc(e); err != nil {
+					return "", err
+				}
+				// every event must reach the client before the next one
+				if !w.Flushed {
+					t.Fatalf("expected event %d to be flushed", i)
+				}
+				if lines := strings.Count(w.Body.String(), "\n"); lines != i+1 {
+					t.Fatalf("expected %d lines after event %d, got %d", i+1, i, lines)
+				}
+			}
+			return "image", nil
+		},
+	}
+	r := &buildRouter{backend: b}
+
+	req := httptest.NewRequest("POST", "/build", nil)
+	if err := r.postBuild(context.Background(), w, req, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	dec := json.NewDecoder(w.Body)
+	for i, expected := range events {
+		var jm jsonmessage.JSONMessage
+		if err := dec.Decode(&jm); err != nil {
+			t.Fatal(err)
+		}
+		// only the aux field is set so that other clients skip the message
+		if jm.Aux == nil || jm.Stream != "" || jm.Status != "" || jm.Error != nil {
+			t.Fatalf("expected event %d to be sent as an aux message, got %+v", i, jm)
+		}
+		var actual backend.BuildProgress
+		if err := json.Unmarshal(*jm.Aux, &actual); err != nil {
+			t.Fatal(err)
+		}
+		if actual != expected {
+			t.Fatalf("expected event %d to be %+v, got %+v", i, expected, actual)
+		}
+	}
+}
+
+func TestGetBuildStatus(t *testing.T) {
+	started := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	finished := started.Add(time.Minute)
+	b := &fakeBackend{statuses: map[string]*types.BuildStatus{
+		"running":  {ID: "running", State: types.BuildStateRunning, CurrentStep: "2", StartedAt: started},
+		"finished": {ID: "finished", State: types.BuildStateSucceeded, CurrentStep: "3", StartedAt: started, FinishedAt: &finished},
+	}}
+	r := &buildRouter{backend: b}
+
+	for id, expected := range b.statuses {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/build/"+id, nil)
+		if err := r.getBuildStatus(context.Background(), w, req, map[string]string{"id": id}); err != nil {
+			t.Fatal(err)
+		}
+
+		var actual types.BuildStatus
+		if err := json.NewDecoder(w.Body).Decode(&actual); err != nil {
+			t.Fatal(err)
+		}
+		if actual.State != expected.State || actual.CurrentStep != expected.CurrentStep || !actual.StartedAt.Equal(started) {
+			t.Fatalf("expected %+v, got %+v", expected, actual)
+		}
+		if expected.FinishedAt == nil {
+			if actual.FinishedAt != nil {
+				t.Fatalf("expected no finish time for %s, got %v", id, actual.FinishedAt)
+			}
+		} else if actual.FinishedAt == nil || !actual.FinishedAt.Equal(finished) {
+			t.Fatalf("expected finish time %v for %s, got %v", finished, id, actual.FinishedAt)
+		}
+	}
+}
+
+func TestGetBuildStatusNotFound(t *testing.T) {
+	r := &buildRouter{backend: &fakeBackend{}}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/build/unknown", nil)
+	err := r.getBuildStatus(context.Background(), w, req, map[string]string{"id": "unknown"})
+	if status := httputils.GetHTTPErrorStatusCode(err); status != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d (%v)", http.StatusNotFound, status, err)
+	}
+}
+
+func TestPostBuildContextTooLarge(t *testing.T) {
+	b := &fakeBackend{
+		build: func(src io.ReadCloser, pg backend.ProgressWriter) (string, error) {
+			return "image", nil
+		},
+	}
+	r := &buildRouter{backend: b, maxContextSize: 10}
+
+	req := httptest.NewRequest("POST", "/build", strings.NewReader(strings.Repeat("x", 11)))
+	err := r.postBuild(context.Background(), httptest.NewRecorder(), req, nil)
+	if status := httputils.GetHTTPErrorStatusCode(err); status != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status %d, got %d (%v)", http.StatusRequestEntityTooLarge, status, err)
+	}
+}
+
+func TestPostBuildContextTooLargeUnknownLength(t *testing.T) {
+	b := &fakeBackend{
+		build: func(src io.ReadCloser, pg backend.ProgressWriter) (string, error) {
+			if _, err := ioutil.ReadAll(src); err != nil {
+				return "", fmt.Errorf("error reading context: %v", err)
+			}
+			return "image", nil
+		},
+	}
+	r := &buildRouter{backend: b, maxContextSize: 10}
+
+	req := httptest.NewRequest("POST", "/build", strings.NewReader(strings.Repeat("x", 11)))
+	req.ContentLength = -1
+	err := r.postBuild(context.Background(), httptest.NewRecorder(), req, nil)
+	if status := httputils.GetHTTPErrorStatusCode(err); status != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status %d, got %d (%v)", http.StatusRequestEntityTooLarge, status, err)
+	}
+
+	req = httptest.NewRequest("POST", "/build", strings.NewReader(strings.Repeat("x", 10)))
+	req.ContentLength = -1
+	if err := r.postBuild(context.Background(), httptest.NewRecorder(), req, nil); err != nil {
+		t.Fatalf("expected a context at the limit to be accepted, got %v", err)
+	}
+}
+
+func TestPostBuildRemoteContext(t *testing.T) {
+	b := &fakeBackend{}
+	r := &buildRouter{backend: b}
+
+	remote := "git://github.com/docker/docker.git"
+	req := httptest.NewRequest("POST", "/build?remote="+url.QueryEscape(remote), nil)
+	if err := r.postBuild(context.Background(), httptest.NewRecorder(), req, nil); err != nil {
+		t.Fatal(err)
+	}
+	if b.remote != remote {
+		t.Fatalf("expected the backend to fetch %s, got %q", remote, b.remote)
+	}
+}
+
+func TestPostBuildRemoteContextInvalidScheme(t *testing.T) {
+	b := &fakeBackend{}
+	r := &buildRouter{backend: b}
+
+	req := httptest.NewRequest("POST", "/build?remote="+url.QueryEscape("file:///etc/passwd"), nil)
+	err := r.postBuild(context.Background(), httptest.NewRecorder(), req, nil)
+	if status := httputils.GetHTTPErrorStatusCode(err); status != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d (%v)", http.StatusBadRequest, status, err)
+	}
+	if b.remote != "" {
+		t.Fatalf("expected the backend not to be called, got remote %q", b.remote)
+	}
+}
@@ -81,4 +81,16 @@ type ProgressWriter struct {
 	StdoutFormatter    *streamformatter.StdoutFormatter
 	StderrFormatter    *streamformatter.StderrFormatter
 	ProgressReaderFunc func(io.ReadCloser) io.ReadCloser
+	// BuildProgressFunc, if set, is called with the progress of each
+	// build step as it occurs.
+	BuildProgressFunc func(BuildProgress) error
+}
+
+// BuildProgress is a status message about a build step, streamed to the
+// client in the aux field of the build's JSON messages.
+type BuildProgress struct {
+	StepID string `json:"stepID,omitempty"`
+	Status string `json:"status,omitempty"`
+	Stream string `json:"stream,omitempty"`
+	Error  string `json:"error,omitempty"`
 }
@@ -175,6 +175,9 @@ type ImageBuildOptions struct {
 	// specified here do not need to have a valid parent chain to match cache.
 	CacheFrom   []string
 	SecurityOpt []string
+	// BuildID identifies the build so that it can be cancelled while it
+	// is in progress.
+	BuildID string
 }
 
 // ImageBuildResponse holds information
@@ -16,6 +16,15 @@ type ContainerCreateConfig struct {
 	HostConfig       *container.HostConfig
 	NetworkingConfig *network.NetworkingConfig
 	AdjustCPUShares  bool
+	// ReuseRWLayerID, if set, names an existing RW layer to attach to the
+	// new container instead of creating a fresh one.
+	ReuseRWLayerID string
+	// InitFunc, if set, replaces the daemon's default init layer setup
+	// when the container's RW layer is created.
+	InitFunc func(root string) error
+	// DryRun, if set, only verifies the configuration. No container is
+	// created and a synthetic ID is returned along with the warnings.
+	DryRun bool
 }
 
 // ContainerRmConfig holds arguments for the container remove
@@ -530,6 +530,32 @@ type ImagesPruneReport struct {
 	SpaceReclaimed uint64
 }
 
+// BuildCachePruneReport contains the response for Engine API:
+// POST "/build/prune"
+type BuildCachePruneReport struct {
+	CachesDeleted  []string
+	SpaceReclaimed uint64
+}
+
+// Build states reported by BuildStatus.
+const (
+	BuildStatePending   = "pending"
+	BuildStateRunning   = "running"
+	BuildStateSucceeded = "succeeded"
+	BuildStateFailed    = "failed"
+)
+
+// BuildStatus contains the response for Engine API:
+// GET "/build/{id}"
+type BuildStatus struct {
+	ID          string
+	State       string
+	CurrentStep string `json:",omitempty"`
+	Error       string `json:",omitempty"`
+	StartedAt   time.Time
+	FinishedAt  *time.Time `json:",omitempty"`
+}
+
 // NetworksPruneReport contains the response for Engine API:
 // POST "/networks/prune"
 type NetworksPruneReport struct {
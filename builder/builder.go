@@ -14,6 +14,7 @@ import (
     "github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/backend"
     "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/image"
 	"github.com/docker/docker/reference"
 	"golang.org/x/net/context"
@@ -114,7 +115,7 @@ type Backend interface {
     FirstContainerExecStart(ctx context.Context, name string, stdin io.ReadCloser, stdout io.Writer, stderr io.Writer) error 
     FirstContainerExecExists(name string) (bool, error)
     SetFirstContainerBuildingStatus(cId string, status bool) error
-    TriggerExitEvent(cId string) error
+    TriggerExitEvent(cId string, exitCode uint32) error
 //    GetFirstContainerBuildingStatus(id string) error
 //    GetFirstContainer(id string) (*containerTy.Container, error)
 
@@ -158,6 +159,9 @@ type Backend interface {
 
 	// SquashImage squashes the fs layers from the provided image down to the specified `to` image
 	SquashImage(from string, to string) (string, error)
+
+	// BuildCachePrune removes the containers left behind by builds.
+	BuildCachePrune(pruneFilters filters.Args) (*types.BuildCachePruneReport, error)
 }
 
 // Image represents a Docker image used by the builder.
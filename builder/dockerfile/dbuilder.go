@@ -7,13 +7,17 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/Sirupsen/logrus"
 	apierrors "github.com/docker/docker/api/errors"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/backend"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/builder"
 	"github.com/docker/docker/builder/dockerfile/parser"
 	"github.com/docker/docker/image"
@@ -80,23 +84,55 @@ type Builder struct {
 
 	imageCache builder.ImageCache
 	from       builder.Image
+
+	// progress, if set, receives the status of each build step.
+	progress func(backend.BuildProgress) error
 }
 
 // BuildManager implements builder.Backend and is shared across all Builder objects.
 type BuildManager struct {
 	backend builder.Backend
+
+	mu     sync.Mutex
+	builds map[string]*buildRecord // builds by build ID
+}
+
+// finishedBuildTTL is how long the status of a finished build stays available
+// before the build is forgotten.
+var finishedBuildTTL = 10 * time.Minute
+
+// buildRecord tracks a build started with a build ID.
+type buildRecord struct {
+	status types.BuildStatus
+	cancel context.CancelFunc
 }
 
 // NewBuildManager creates a BuildManager.
 func NewBuildManager(b builder.Backend) (bm *BuildManager) {
-	return &BuildManager{backend: b}
+	return &BuildManager{
+		backend: b,
+		builds:  make(map[string]*buildRecord),
+	}
 }
 
 // BuildFromContext builds a new image from a given context.
-func (bm *BuildManager) BuildFromContext(ctx context.Context, src io.ReadCloser, remote string, buildOptions *types.ImageBuildOptions, pg backend.ProgressWriter) (string, error) {
+func (bm *BuildManager) BuildFromContext(ctx context.Context, src io.ReadCloser, remote string, buildOptions *types.ImageBuildOptions, pg backend.ProgressWriter) (imageID string, err error) {
 	if buildOptions.Squash && !bm.backend.HasExperimental() {
 		return "", apierrors.NewBadRequestError(errors.New("squash is only supported with experimental mode"))
 	}
+	if id := buildOptions.BuildID; id != "" {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		defer cancel()
+		if err := bm.register(id, cancel); err != nil {
+			return "", err
+		}
+		defer func() {
+			bm.finish(id, err)
+		}()
+		pg.BuildProgressFunc = bm.trackProgress(id, pg.BuildProgressFunc)
+	}
+
 	buildContext, dockerfileName, err := builder.DetectContextFromRemoteURL(src, remote, pg.ProgressReaderFunc)
 	if err != nil {
 		return "", err
@@ -114,9 +150,112 @@ func (bm *BuildManager) BuildFromContext(ctx context.Context, src io.ReadCloser,
 	if err != nil {
 		return "", err
 	}
+	b.progress = pg.BuildProgressFunc
 	return b.build(pg.StdoutFormatter, pg.StderrFormatter, pg.Output)
 }
 
+// CancelBuild cancels the in-progress build with the given build ID.
+func (bm *BuildManager) CancelBuild(id string) error {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	r, ok := bm.builds[id]
+	if !ok {
+		return apierrors.NewRequestNotFoundError(fmt.Errorf("no such build: %s", id))
+	}
+	if r.status.FinishedAt != nil {
+		return apierrors.NewRequestConflictError(fmt.Errorf("build %s has already finished", id))
+	}
+	r.cancel()
+	return nil
+}
+
+// BuildStatus returns the state of the build with the given build ID.
+func (bm *BuildManager) BuildStatus(id string) (*types.BuildStatus, error) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	r, ok := bm.builds[id]
+	if !ok {
+		return nil, apierrors.NewRequestNotFoundError(fmt.Errorf("no such build: %s", id))
+	}
+	status := r.status
+	return &status, nil
+}
+
+// PruneBuildCache removes the containers left behind by builds, and forgets
+// about the builds that have finished.
+func (bm *BuildManager) PruneBuildCache(pruneFilters filters.Args) (*types.BuildCachePruneReport, error) {
+	bm.mu.Lock()
+	for id, r := range bm.builds {
+		if r.status.FinishedAt != nil {
+			delete(bm.builds, id)
+		}
+	}
+	bm.mu.Unlock()
+	return bm.backend.BuildCachePrune(pruneFilters)
+}
+
+func (bm *BuildManager) register(id string, cancel context.CancelFunc) error {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	if r, exists := bm.builds[id]; exists && r.status.FinishedAt == nil {
+		return apierrors.NewRequestConflictError(fmt.Errorf("build %s is already in progress", id))
+	}
+	bm.builds[id] = &buildRecord{
+		status: types.BuildStatus{
+			ID:        id,
+			State:     types.BuildStatePending,
+			StartedAt: time.Now().UTC(),
+		},
+		cancel: cancel,
+	}
+	return nil
+}
+
+// trackProgress wraps progressFunc to record the current step of build id.
+func (bm *BuildManager) trackProgress(id string, progressFunc func(backend.BuildProgress) error) func(backend.BuildProgress) error {
+	return func(p backend.BuildProgress) error {
+		bm.mu.Lock()
+		if r, ok := bm.builds[id]; ok && p.Status == "running" {
+			r.status.State = types.BuildStateRunning
+			r.status.CurrentStep = p.StepID
+		}
+		bm.mu.Unlock()
+		if progressFunc == nil {
+			return nil
+		}
+		return progressFunc(p)
+	}
+}
+
+func (bm *BuildManager) finish(id string, err error) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	r, ok := bm.builds[id]
+	if !ok {
+		return
+	}
+	now := time.Now().UTC()
+	r.status.FinishedAt = &now
+	r.status.State = types.BuildStateSucceeded
+	if err != nil {
+		r.status.State = types.BuildStateFailed
+		r.status.Error = err.Error()
+	}
+	time.AfterFunc(finishedBuildTTL, func() {
+		bm.forget(id, r)
+	})
+}
+
+// forget removes the record r of build id, unless the ID has been reused by
+// another build since.
+func (bm *BuildManager) forget(id string, r *buildRecord) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	if bm.builds[id] == r {
+		delete(bm.builds, id)
+	}
+}
+
 // NewBuilder creates a new Dockerfile builder from an optional dockerfile and a Config.
 // If dockerfile is nil, the Dockerfile specified by Config.DockerfileName,
 // will be read from the Context passed to Build().
@@ -289,7 +428,10 @@ func (b *Builder) build(stdout io.Writer, stderr io.Writer, out io.Writer) (stri
         }
 */
 
+		stepID := strconv.Itoa(i + 1)
+		b.reportProgress(backend.BuildProgress{StepID: stepID, Status: "running"})
 		if _, err := b.dispatch(i, total, n, tmpFirstContainerID); err != nil {
+			b.reportProgress(backend.BuildProgress{StepID: stepID, Error: err.Error()})
 			if b.options.ForceRemove {
 				b.clearTmp()
                 fmt.Println("builder.go/build() Don't force remove tmpContainer")
@@ -306,6 +448,7 @@ func (b *Builder) build(stdout io.Writer, stderr io.Writer, out io.Writer) (stri
 
 		shortImgID = stringid.TruncateID(b.image)
 		fmt.Fprintf(b.Stdout, " ---> %s builder.go/build()\n", shortImgID)
+		b.reportProgress(backend.BuildProgress{StepID: stepID, Status: "done", Stream: shortImgID})
 		if b.options.Remove {
 			b.clearTmp()
             fmt.Println("builder.go/build() Don't remove tmpContainer")
@@ -352,6 +495,17 @@ func (b *Builder) build(stdout io.Writer, stderr io.Writer, out io.Writer) (stri
 	return b.image, nil
 }
 
+// reportProgress sends the status of a build step to the progress
+// function, if there is one.
+func (b *Builder) reportProgress(p backend.BuildProgress) {
+	if b.progress == nil {
+		return
+	}
+	if err := b.progress(p); err != nil {
+		logrus.Debugf("[BUILDER] failed to report progress of step %s: %v", p.StepID, err)
+	}
+}
+
 // Cancel cancels an ongoing Dockerfile build.
 func (b *Builder) Cancel() {
 	b.cancel()
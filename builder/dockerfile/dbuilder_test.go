@@ -0,0 +1,57 @@
+package dockerfile
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestBuildManagerForgetsFinishedBuilds(t *testing.T) {
+	defer func(ttl time.Duration) { finishedBuildTTL = ttl }(finishedBuildTTL)
+	finishedBuildTTL = 10 * time.Millisecond
+
+	bm := NewBuildManager(nil)
+	_, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := bm.register("build1", cancel); err != nil {
+		t.Fatal(err)
+	}
+	bm.finish("build1", nil)
+	if _, err := bm.BuildStatus("build1"); err != nil {
+		t.Fatalf("expected the finished build to still be reported, got %v", err)
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		bm.mu.Lock()
+		n := len(bm.builds)
+		bm.mu.Unlock()
+		if n == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected the finished build to be forgotten")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestBuildManagerKeepsReusedBuildID(t *testing.T) {
+	bm := NewBuildManager(nil)
+	_, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := bm.register("build1", cancel); err != nil {
+		t.Fatal(err)
+	}
+	old := bm.builds["build1"]
+	bm.finish("build1", nil)
+	if err := bm.register("build1", cancel); err != nil {
+		t.Fatal(err)
+	}
+
+	bm.forget("build1", old)
+	if _, err := bm.BuildStatus("build1"); err != nil {
+		t.Fatalf("expected the build reusing the ID to be kept, got %v", err)
+	}
+}
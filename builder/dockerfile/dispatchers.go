@@ -565,7 +565,8 @@ func run(b *Builder, args []string, attributes map[string]bool, original string,
     firstDockerCmd.SetArgs(tmpCmd) //c.args  []string
 
 //    if err := firstDockerCmd.ExecuteInFirstContainer(); err != nil {
-    if err := firstDockerCmd.Execute(); err != nil {
+    stepErr := firstDockerCmd.Execute()
+    if stepErr != nil {
         fmt.Println("builder/dockerfile/dispatchers.go  run() excute is err!!!")
     }
 
@@ -582,7 +583,9 @@ func run(b *Builder, args []string, attributes map[string]bool, original string,
 //    }
 //    fmt.Println("dockerfile/dispatchers.go  run() after startFirstContainerExecStart()")
     fmt.Println("dockerfile/dispatchers.go  run() before stopContainerBeforeCommit()")
-    b.stopContainerBeforeCommit(tmpContainerID)
+    if err := b.stopContainerBeforeCommit(tmpContainerID, stepExitCode(stepErr)); err != nil {
+        return "", err
+    }
 
 
 	// revert to original config environment and set the command string to
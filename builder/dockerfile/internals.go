@@ -27,6 +27,7 @@ import (
 	"github.com/docker/docker/api/types/strslice"
 	"github.com/docker/docker/builder"
 	"github.com/docker/docker/builder/dockerfile/parser"
+	"github.com/docker/docker/cli"
 	"github.com/docker/docker/pkg/archive"
 	"github.com/docker/docker/pkg/httputils"
 	"github.com/docker/docker/pkg/ioutils"
@@ -748,7 +749,19 @@ func (b *Builder) run(cID string) (err error) {
 
 
 
-func (b *Builder) stopContainerBeforeCommit(cID string) (err error) {
+// stepExitCode returns the exit status of a build step from the error returned
+// by the exec running it.
+func stepExitCode(err error) uint32 {
+	if err == nil {
+		return 0
+	}
+	if sterr, ok := err.(cli.StatusError); ok {
+		return uint32(sterr.StatusCode)
+	}
+	return 1
+}
+
+func (b *Builder) stopContainerBeforeCommit(cID string, exitCode uint32) (err error) {
     fmt.Println("dockerfile/internals.go  stopContainerBeforeCommit()")
     
     fmt.Println("dockerfile/internals.go  stopContainerBeforeCommit() set false to building status!")    
@@ -774,7 +787,7 @@ func (b *Builder) stopContainerBeforeCommit(cID string) (err error) {
     }
 */
 
-    if err := b.docker.TriggerExitEvent(cID); err != nil {
+    if err := b.docker.TriggerExitEvent(cID, exitCode); err != nil {
         fmt.Println("dockerfile/internals.go  stopContainerBeforeCommit() triggger exit event  err!!!")
         return err
     }
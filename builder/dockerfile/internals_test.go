@@ -7,6 +7,7 @@ import (
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/builder"
+	"github.com/docker/docker/cli"
 	"github.com/docker/docker/pkg/archive"
 )
 
@@ -93,3 +94,19 @@ func readAndCheckDockerfile(t *testing.T, testName, contextDir, dockerfilePath,
 		t.Fatalf("Wrong error message. Should be \"%s\". Got \"%s\"", expectedError, err.Error())
 	}
 }
+
+func TestStepExitCode(t *testing.T) {
+	cases := []struct {
+		err      error
+		expected uint32
+	}{
+		{nil, 0},
+		{cli.StatusError{StatusCode: 3}, 3},
+		{fmt.Errorf("cannot exec in container"), 1},
+	}
+	for _, c := range cases {
+		if code := stepExitCode(c.err); code != c.expected {
+			t.Fatalf("expected exit code %d for %v, got %d", c.expected, c.err, code)
+		}
+	}
+}
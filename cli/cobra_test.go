@@ -0,0 +1,493 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func TestExecHooks(t *testing.T) {
+	var preCmd, postCmd *cobra.Command
+	var postErr error
+	cobra.SetPreExecHook(func(cmd *cobra.Command) {
+		preCmd = cmd
+	})
+	cobra.SetPostExecHook(func(cmd *cobra.Command, err error) {
+		postCmd, postErr = cmd, err
+	})
+	defer cobra.SetPreExecHook(nil)
+	defer cobra.SetPostExecHook(nil)
+
+	failure := errors.New("failure")
+	root := &cobra.Command{Use: "docker", SilenceUsage: true, SilenceErrors: true}
+	sub := &cobra.Command{
+		Use: "sub",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if preCmd != cmd {
+				t.Fatal("expected the pre-exec hook to run before the command")
+			}
+			return failure
+		},
+	}
+	root.AddCommand(sub)
+
+	root.SetArgs([]string{"sub"})
+	if err := root.Execute(); err != failure {
+		t.Fatalf("expected the command error, got %v", err)
+	}
+	if preCmd != sub || postCmd != sub {
+		t.Fatalf("expected both hooks to get sub, got %v and %v", preCmd, postCmd)
+	}
+	if postErr != failure {
+		t.Fatalf("expected the post-exec hook to get the command error, got %v", postErr)
+	}
+}
+
+func TestExecuteCapture(t *testing.T) {
+	root := &cobra.Command{Use: "docker"}
+	root.AddCommand(&cobra.Command{
+		Use:   "sub",
+		Short: "A subcommand",
+		Run:   func(cmd *cobra.Command, args []string) {},
+	})
+
+	root.SetArgs([]string{"sub", "--help"})
+	stdout, stderr, err := root.ExecuteCapture()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(stdout, "A subcommand") || stderr != "" {
+		t.Fatalf("expected the help on stdout only, got stdout %q and stderr %q", stdout, stderr)
+	}
+
+	root.SetArgs([]string{"sub", "--bogus"})
+	stdout, stderr, err = root.ExecuteCapture()
+	if err == nil {
+		t.Fatal("expected an unknown flag error")
+	}
+	if !strings.Contains(stderr, "unknown flag: --bogus") || stdout != "" {
+		t.Fatalf("expected the error on stderr only, got stdout %q and stderr %q", stdout, stderr)
+	}
+}
+
+func newOutputTestCommand() *cobra.Command {
+	root := &cobra.Command{Use: "docker"}
+	root.AddCommand(&cobra.Command{
+		Use:   "sub",
+		Short: "A subcommand",
+		Run:   func(cmd *cobra.Command, args []string) {},
+	})
+	return root
+}
+
+func TestSetOut(t *testing.T) {
+	root := newOutputTestCommand()
+	out, output := new(bytes.Buffer), new(bytes.Buffer)
+	root.SetOutput(output)
+	root.SetOut(out)
+
+	root.SetArgs([]string{"sub", "--help"})
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "A subcommand") || output.Len() != 0 {
+		t.Fatalf("expected the help to be written to the SetOut writer only, got %q and %q", out.String(), output.String())
+	}
+
+	out.Reset()
+	root.SetArgs([]string{"sub", "--bogus"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("expected an unknown flag error")
+	}
+	if !strings.Contains(output.String(), "unknown flag: --bogus") || out.Len() != 0 {
+		t.Fatalf("expected the error to be written to the SetOutput writer only, got %q and %q", output.String(), out.String())
+	}
+}
+
+func TestSetErr(t *testing.T) {
+	root := newOutputTestCommand()
+	errOut, output := new(bytes.Buffer), new(bytes.Buffer)
+	root.SetOutput(output)
+	root.SetErr(errOut)
+
+	root.SetArgs([]string{"sub", "--bogus"})
+	if err := root.Execute(); err == nil {
+		t.Fatal("expected an unknown flag error")
+	}
+	if !strings.Contains(errOut.String(), "unknown flag: --bogus") || output.Len() != 0 {
+		t.Fatalf("expected the error to be written to the SetErr writer only, got %q and %q", errOut.String(), output.String())
+	}
+
+	errOut.Reset()
+	root.SetArgs([]string{"sub", "--help"})
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(output.String(), "A subcommand") || errOut.Len() != 0 {
+		t.Fatalf("expected the help to be written to the SetOutput writer only, got %q and %q", output.String(), errOut.String())
+	}
+}
+
+func TestMissingRequiredFlags(t *testing.T) {
+	var missing []string
+	root := &cobra.Command{Use: "docker"}
+	root.PersistentFlags().String("host", "", "")
+	root.MarkPersistentFlagRequired("host")
+	sub := &cobra.Command{
+		Use: "build",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			missing = cmd.MissingRequiredFlags()
+			return nil
+		},
+		Run: func(cmd *cobra.Command, args []string) {},
+	}
+	sub.Flags().String("file", "", "")
+	sub.Flags().String("context", "", "")
+	sub.Flags().String("tag", "", "")
+	sub.MarkFlagRequired("file")
+	sub.MarkFlagRequired("context")
+	root.AddCommand(sub)
+
+	root.SetArgs([]string{"build", "--file", "Dockerfile", "--tag", "latest"})
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if len(missing) != 2 || missing[0] != "context" || missing[1] != "host" {
+		t.Fatalf("expected context and host to be missing, got %v", missing)
+	}
+}
+
+func TestAddCommandCollision(t *testing.T) {
+	for _, tc := range []struct {
+		cmd  *cobra.Command
+		name string
+	}{
+		{&cobra.Command{Use: "build"}, "build"},
+		{&cobra.Command{Use: "extbuild", Aliases: []string{"b"}}, "b"},
+		{&cobra.Command{Use: "b"}, "b"},
+	} {
+		root := &cobra.Command{Use: "docker"}
+		root.AddCommand(&cobra.Command{Use: "build", Aliases: []string{"b"}})
+		func() {
+			defer func() {
+				r := recover()
+				if r == nil {
+					t.Fatalf("expected adding %s to panic", tc.cmd.Name())
+				}
+				if msg := fmt.Sprint(r); !strings.Contains(msg, fmt.Sprintf("%q", tc.name)) {
+					t.Fatalf("expected the collision on %q to be reported, got %q", tc.name, msg)
+				}
+			}()
+			root.AddCommand(tc.cmd)
+		}()
+	}
+
+	root := &cobra.Command{Use: "docker"}
+	root.AddCommand(&cobra.Command{Use: "build", Aliases: []string{"b"}})
+	root.AddCommand(&cobra.Command{Use: "exec", Aliases: []string{"e"}})
+	if len(root.Commands()) != 2 {
+		t.Fatalf("expected 2 commands, got %d", len(root.Commands()))
+	}
+}
+
+func TestUserHelpCommandReplacesDefault(t *testing.T) {
+	var called bool
+	root := &cobra.Command{Use: "docker"}
+	root.AddCommand(&cobra.Command{Use: "build", Run: func(*cobra.Command, []string) {}})
+	root.AddCommand(&cobra.Command{
+		Use: "help",
+		Run: func(*cobra.Command, []string) { called = true },
+	})
+	root.SetArgs([]string{"help"})
+	for i := 0; i < 2; i++ {
+		if err := root.Execute(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !called {
+		t.Fatal("expected the user help command to run")
+	}
+	if len(root.Commands()) != 2 {
+		t.Fatalf("expected 2 commands, got %d", len(root.Commands()))
+	}
+}
+
+func newFindTestCommand() *cobra.Command {
+	root := &cobra.Command{Use: "docker"}
+	root.PersistentFlags().String("config", "", "")
+	root.PersistentFlags().String("label", "", "")
+	root.PersistentFlags().BoolP("debug", "D", false, "")
+	image := &cobra.Command{Use: "image"}
+	image.AddCommand(&cobra.Command{Use: "build", Run: func(*cobra.Command, []string) {}})
+	root.AddCommand(image)
+	return root
+}
+
+func TestFindStripsFlags(t *testing.T) {
+	root := newFindTestCommand()
+	for _, tc := range []struct {
+		args []string
+		path string
+	}{
+		{[]string{"--config", "x", "image", "build"}, "docker image build"},
+		{[]string{"--config=x", "image", "build"}, "docker image build"},
+		{[]string{"-D", "image", "build"}, "docker image build"},
+		{[]string{"--debug", "image", "build"}, "docker image build"},
+		{[]string{"--label=\"a b\"", "image", "build"}, "docker image build"},
+		{[]string{"--label", "\"a", "x", "b\"", "image", "build"}, "docker image build"},
+		{[]string{"--config", "-", "image", "build"}, "docker image build"},
+		{[]string{"image", "-", "build"}, "docker image"},
+	} {
+		cmd, _, err := root.Find(tc.args)
+		if err != nil {
+			t.Fatalf("%v: %v", tc.args, err)
+		}
+		if cmd.CommandPath() != tc.path {
+			t.Fatalf("%v: expected %q, got %q", tc.args, tc.path, cmd.CommandPath())
+		}
+	}
+
+	// A lone dash is an argument, not a flag, the root takes no arguments.
+	if _, _, err := root.Find([]string{"-", "image", "build"}); err == nil || !strings.Contains(err.Error(), `unknown command "-"`) {
+		t.Fatalf("expected the dash to be reported as an unknown command, got %v", err)
+	}
+}
+
+func TestFindRandomArgs(t *testing.T) {
+	root := newFindTestCommand()
+	tokens := []string{"image", "build", "-", "--", "-D", "--debug", "--config", "--config=x", "--label=\"a", "b\"", "\"", "x", "", "-x", "--unknown"}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		args := make([]string, r.Intn(6))
+		for j := range args {
+			args[j] = tokens[r.Intn(len(tokens))]
+		}
+		cmd, _, err := root.Find(args)
+		if err == nil && cmd == nil {
+			t.Fatalf("%q: expected a command or an error", args)
+		}
+	}
+}
+
+func TestFindArgsAfterTerminator(t *testing.T) {
+	var execArgs []string
+	root := newFindTestCommand()
+	exec := &cobra.Command{
+		Use: "exec",
+		Run: func(cmd *cobra.Command, args []string) {
+			execArgs = args
+		},
+	}
+	exec.Flags().StringP("user", "u", "", "")
+	exec.Flags().BoolP("detach", "d", false, "")
+	root.AddCommand(exec)
+
+	args := []string{"--config", "x", "exec", "-d", "-u", "root", "c1", "--", "sh", "-c", "image build", "--config"}
+	cmd, _, err := root.Find(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd != exec {
+		t.Fatalf("expected exec to be found, got %q", cmd.CommandPath())
+	}
+	root.SetArgs(args)
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(execArgs, ",") != "c1,sh,-c,image build,--config" {
+		t.Fatalf("expected the arguments after -- to be positional, got %q", execArgs)
+	}
+
+	// "image" follows the terminator, so it is an argument of the root.
+	if _, _, err := root.Find([]string{"--", "image", "build"}); err == nil || !strings.Contains(err.Error(), `unknown command "image"`) {
+		t.Fatalf("expected image to be an argument, got %v", err)
+	}
+	cmd, _, err = root.Find([]string{"image", "--", "build"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmd.CommandPath() != "docker image" {
+		t.Fatalf("expected docker image, got %q", cmd.CommandPath())
+	}
+}
+
+func TestTraversePersistentFlags(t *testing.T) {
+	root := &cobra.Command{Use: "docker", SilenceUsage: true, SilenceErrors: true, TraverseChildren: true}
+	root.PersistentFlags().Bool("verbose", false, "")
+
+	var verbose bool
+	sub := &cobra.Command{
+		Use: "sub",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			verbose, err = cmd.Flags().GetBool("verbose")
+			return err
+		},
+	}
+	sub.Flags().String("flag", "", "")
+	root.AddCommand(sub)
+
+	// the boolean --verbose must not take sub as its value
+	root.SetArgs([]string{"--verbose", "sub", "--flag", "value"})
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if !verbose {
+		t.Fatal("expected sub to read --verbose set before it")
+	}
+}
+
+func TestFlagUsagesWrapped(t *testing.T) {
+	defer os.Setenv("COLUMNS", os.Getenv("COLUMNS"))
+	os.Setenv("COLUMNS", "60")
+
+	description := "Name of the Dockerfile to read the build instructions from, relative to the build context unless absolute"
+	cmd := &cobra.Command{Use: "build", Run: func(cmd *cobra.Command, args []string) {}}
+	cmd.Flags().StringP("file", "f", "", description)
+
+	usage := cmd.UsageString()
+	var wrapped []string
+	for _, line := range strings.Split(usage, "\n") {
+		if len(line) > 60 {
+			t.Fatalf("expected lines of at most 60 columns, got %d: %q", len(line), line)
+		}
+		if strings.Contains(line, "--file") || (len(wrapped) > 0 && strings.HasPrefix(line, "       ")) {
+			wrapped = append(wrapped, line)
+		}
+	}
+	if len(wrapped) < 2 {
+		t.Fatalf("expected the usage of --file to wrap, got:\n%s", usage)
+	}
+	if !strings.Contains(strings.Join(strings.Fields(usage), " "), description) {
+		t.Fatalf("expected the usage to contain %q, got:\n%s", description, usage)
+	}
+}
+
+func TestDisableFlagsInUseLine(t *testing.T) {
+	cmd := &cobra.Command{Use: "exec CONTAINER COMMAND", Run: func(cmd *cobra.Command, args []string) {}}
+	cmd.Flags().Bool("detach", false, "")
+
+	if usage := cmd.UsageString(); !strings.Contains(usage, "exec CONTAINER COMMAND [flags]") {
+		t.Fatalf("expected the use line to end with [flags], got:\n%s", usage)
+	}
+
+	cmd.DisableFlagsInUseLine = true
+	usage := cmd.UsageString()
+	if strings.Contains(usage, "[flags]") {
+		t.Fatalf("expected [flags] to be omitted, got:\n%s", usage)
+	}
+	if !strings.Contains(usage, "exec CONTAINER COMMAND") {
+		t.Fatalf("expected the use line in the usage, got:\n%s", usage)
+	}
+}
+
+func TestDisableFlagParsingHelp(t *testing.T) {
+	for _, arg := range []string{"-h", "--help"} {
+		ran := false
+		cmd := &cobra.Command{
+			Use:                "exec",
+			DisableFlagParsing: true,
+			Run:                func(cmd *cobra.Command, args []string) { ran = true },
+		}
+		out := new(bytes.Buffer)
+		cmd.SetOutput(out)
+		cmd.SetArgs([]string{arg})
+		if err := cmd.Execute(); err != nil {
+			t.Fatal(err)
+		}
+		if ran {
+			t.Fatalf("expected %s to show help instead of running the command", arg)
+		}
+		if !strings.Contains(out.String(), "Usage:") {
+			t.Fatalf("expected the help for %s, got %q", arg, out.String())
+		}
+	}
+}
+
+func TestDisableFlagParsingPassthrough(t *testing.T) {
+	var got []string
+	cmd := &cobra.Command{
+		Use:                "exec",
+		DisableFlagParsing: true,
+		Run:                func(cmd *cobra.Command, args []string) { got = args },
+	}
+	args := []string{"--rm", "builder", "make", "-h", "--help", "--", "-x"}
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(got, " ") != strings.Join(args, " ") {
+		t.Fatalf("expected %v to be passed through, got %v", args, got)
+	}
+}
+
+func TestDocumentedCommands(t *testing.T) {
+	run := func(*cobra.Command, []string) {}
+	root := &cobra.Command{Use: "root", Run: run}
+	root.AddCommand(
+		&cobra.Command{Use: "visible", Run: run},
+		&cobra.Command{Use: "hidden", Hidden: true, Run: run},
+		&cobra.Command{Use: "deprecated", Deprecated: "do not use", Run: run},
+		&cobra.Command{Use: "topic"},
+	)
+
+	names := func(cmds []*cobra.Command) []string {
+		var names []string
+		for _, c := range cmds {
+			names = append(names, c.Name())
+		}
+		return names
+	}
+	if actual := names(root.DocumentedCommands(false)); !reflect.DeepEqual(actual, []string{"visible"}) {
+		t.Fatalf("expected only the available commands to be documented, got %v", actual)
+	}
+	if actual := names(root.DocumentedCommands(true)); !reflect.DeepEqual(actual, []string{"hidden", "visible"}) {
+		t.Fatalf("expected the hidden commands to be documented too, got %v", actual)
+	}
+}
+
+func TestExecTimeout(t *testing.T) {
+	var cancelled bool
+	cmd := &cobra.Command{
+		Use:         "step",
+		ExecTimeout: 10 * time.Millisecond,
+		RunEContext: func(ctx context.Context, cmd *cobra.Command, args []string) error {
+			select {
+			case <-ctx.Done():
+				cancelled = true
+			case <-time.After(10 * time.Second):
+			}
+			return nil
+		},
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+	cmd.SetArgs([]string{})
+
+	start := time.Now()
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Fatalf("expected Execute to return at the timeout, took %s", elapsed)
+	}
+	if !cancelled {
+		t.Fatal("expected RunEContext to have returned on its context being cancelled")
+	}
+
+	cmd.ExecTimeout = time.Second
+	cmd.RunEContext = func(ctx context.Context, cmd *cobra.Command, args []string) error { return ctx.Err() }
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("expected no error within the timeout, got %v", err)
+	}
+}
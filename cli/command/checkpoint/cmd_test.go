@@ -0,0 +1,35 @@
+package checkpoint
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/cli/command"
+	"github.com/spf13/cobra"
+)
+
+func TestCheckpointDirCompletion(t *testing.T) {
+	out := new(bytes.Buffer)
+	cmd := NewCheckpointCommand(command.NewDockerCli(nil, out, out))
+
+	for _, subcmd := range cmd.Commands() {
+		flag := subcmd.Flags().Lookup("checkpoint-dir")
+		if flag == nil {
+			t.Fatalf("expected %q to have a --checkpoint-dir flag", subcmd.CommandPath())
+		}
+		if _, ok := flag.Annotations[cobra.BashCompSubdirsInDir]; !ok {
+			t.Fatalf("expected --checkpoint-dir of %q to be marked as a directory, got %v", subcmd.CommandPath(), flag.Annotations)
+		}
+	}
+
+	completion := new(bytes.Buffer)
+	if err := cmd.GenBashCompletion(completion); err != nil {
+		t.Fatal(err)
+	}
+	expected := `flags_with_completion+=("--checkpoint-dir")
+    flags_completion+=("_filedir -d")`
+	if !strings.Contains(completion.String(), expected) {
+		t.Fatalf("expected completion to complete directories for --checkpoint-dir, got:\n%s", completion.String())
+	}
+}
@@ -31,6 +31,7 @@ func newListCommand(dockerCli *command.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.checkpointDir, "checkpoint-dir", "", "", "Use a custom checkpoint storage directory")
+	cmd.MarkFlagDirname("checkpoint-dir")
 
 	return cmd
 
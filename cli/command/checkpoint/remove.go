@@ -28,6 +28,7 @@ func newRemoveCommand(dockerCli *command.DockerCli) *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.StringVarP(&opts.checkpointDir, "checkpoint-dir", "", "", "Use a custom checkpoint storage directory")
+	cmd.MarkFlagDirname("checkpoint-dir")
 
 	return cmd
 }
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 	
     //"github.com/Sirupsen/logrus"
     //"github.com/docker/docker/cli"
@@ -58,6 +59,8 @@ type DockerCli struct {
 //added characters
     container       string
     execConfig      *types.ExecConfig
+	ctx             context.Context
+	cancel          context.CancelFunc
 }
 
 func (cli *DockerCli) SetCliclient(c client.APIClient) error {
@@ -79,6 +82,33 @@ func NewFirstDockerCli(in io.ReadCloser, out, err io.Writer, c string, ec *types
 }
 
 
+// Context returns the context commands use for their API calls. It is
+// cancelled once the timeout set with SetTimeout expires.
+func (cli *DockerCli) Context() context.Context {
+	if cli.ctx == nil {
+		return context.Background()
+	}
+	return cli.ctx
+}
+
+// SetTimeout bounds the context returned by Context to timeout from now.
+// A zero timeout removes the bound.
+func (cli *DockerCli) SetTimeout(timeout time.Duration) {
+	cli.Cancel()
+	if timeout <= 0 {
+		cli.ctx, cli.cancel = nil, nil
+		return
+	}
+	cli.ctx, cli.cancel = context.WithTimeout(context.Background(), timeout)
+}
+
+// Cancel cancels the context returned by Context, if a timeout is set.
+func (cli *DockerCli) Cancel() {
+	if cli.cancel != nil {
+		cli.cancel()
+	}
+}
+
 // HasExperimental returns true if experimental features are accessible.
 func (cli *DockerCli) HasExperimental() bool {
 	return cli.hasExperimental
@@ -116,6 +146,11 @@ func (cli *DockerCli) ShowHelp(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// SetConfigFile sets the ConfigFile, instead of loading it in Initialize
+func (cli *DockerCli) SetConfigFile(configFile *configfile.ConfigFile) {
+	cli.configFile = configFile
+}
+
 // ConfigFile returns the ConfigFile
 func (cli *DockerCli) ConfigFile() *configfile.ConfigFile {
 	return cli.configFile
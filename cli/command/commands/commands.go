@@ -2,10 +2,14 @@ package commands
 
 import (
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/docker/docker/cli/command"
 	"github.com/docker/docker/cli/command/checkpoint"
 	"github.com/docker/docker/cli/command/container"
+	"github.com/docker/docker/cli/command/extbuild"
 	"github.com/docker/docker/cli/command/image"
 	"github.com/docker/docker/cli/command/network"
 	"github.com/docker/docker/cli/command/node"
@@ -18,11 +22,20 @@ import (
 	"github.com/docker/docker/cli/command/system"
 	"github.com/docker/docker/cli/command/volume"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
+// commandPriority lists the commands shown first in help, in order. The
+// other commands follow, sorted by name.
+var commandPriority = []string{"build", "extbuild"}
+
 // AddCommands adds all the commands from cli/command to the root command
 func AddCommands(cmd *cobra.Command, dockerCli *command.DockerCli) {
-	cmd.AddCommand(
+	// exec-first is used by the build dispatcher, not meant for users.
+	execInFirstContainer := container.RunExecInFirstContainer(dockerCli)
+	execInFirstContainer.Hidden = true
+
+	cmds := []*cobra.Command{
 		node.NewNodeCommand(dockerCli),
 		service.NewServiceCommand(dockerCli),
 		swarm.NewSwarmCommand(dockerCli),
@@ -32,12 +45,14 @@ func AddCommands(cmd *cobra.Command, dockerCli *command.DockerCli) {
 		system.NewSystemCommand(dockerCli),
 		container.NewRunCommand(dockerCli),
 		image.NewBuildCommand(dockerCli),
+		extbuild.NewExtBuildCommand(dockerCli),
 		network.NewNetworkCommand(dockerCli),
 		hide(system.NewEventsCommand(dockerCli)),
 		registry.NewLoginCommand(dockerCli),
 		registry.NewLogoutCommand(dockerCli),
 		registry.NewSearchCommand(dockerCli),
 		system.NewVersionCommand(dockerCli),
+		system.NewCompletionCommand(dockerCli),
 		volume.NewVolumeCommand(dockerCli),
 		hide(system.NewInfoCommand(dockerCli)),
 		hide(container.NewAttachCommand(dockerCli)),
@@ -46,7 +61,7 @@ func AddCommands(cmd *cobra.Command, dockerCli *command.DockerCli) {
 		hide(container.NewCreateCommand(dockerCli)),
 		hide(container.NewDiffCommand(dockerCli)),
 		hide(container.NewExecCommand(dockerCli)),
-        //hide(container.RunExecInFirstContainer(dockerCli)),
+		execInFirstContainer,
 		hide(container.NewExportCommand(dockerCli)),
 		hide(container.NewKillCommand(dockerCli)),
 		hide(container.NewLogsCommand(dockerCli)),
@@ -77,16 +92,108 @@ func AddCommands(cmd *cobra.Command, dockerCli *command.DockerCli) {
 		stack.NewTopLevelDeployCommand(dockerCli),
 		checkpoint.NewCheckpointCommand(dockerCli),
 		plugin.NewPluginCommand(dockerCli),
-	)
+	}
+	prioritizeCommands(cmds, commandPriority)
+	cmd.AddCommand(cmds...)
+
+	hideCommands(cmd, os.Getenv("DOCKER_HIDDEN_COMMANDS"))
+	addTimeoutFlag(cmd, dockerCli)
+}
+
+// addTimeoutFlag adds the persistent --timeout flag to cmd. When set, the
+// context of dockerCli is cancelled once the timeout expires, aborting the
+// running command.
+func addTimeoutFlag(cmd *cobra.Command, dockerCli *command.DockerCli) {
+	var timeout time.Duration
+	cmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "Abort the command after the given duration (0 for no timeout)")
 
+	preRunE := cmd.PersistentPreRunE
+	cmd.PersistentPreRunE = func(ccmd *cobra.Command, args []string) error {
+		dockerCli.SetTimeout(timeout)
+		if preRunE != nil {
+			return preRunE(ccmd, args)
+		}
+		return nil
+	}
+	postRunE := cmd.PersistentPostRunE
+	cmd.PersistentPostRunE = func(ccmd *cobra.Command, args []string) error {
+		defer dockerCli.Cancel()
+		if postRunE != nil {
+			return postRunE(ccmd, args)
+		}
+		return nil
+	}
+}
+
+// prioritizeCommands tags the commands named in priority so that they are
+// sorted first, in the same order.
+func prioritizeCommands(cmds []*cobra.Command, priority []string) {
+	for i, name := range priority {
+		for _, cmd := range cmds {
+			if cmd.Name() != name {
+				continue
+			}
+			if cmd.Tags == nil {
+				cmd.Tags = make(map[string]string)
+			}
+			cmd.Tags[cobra.CommandPriorityTag] = strconv.Itoa(i)
+		}
+	}
+}
+
+// hideCommands hides the subcommands of cmd named in names, a comma-separated
+// list of command names.
+func hideCommands(cmd *cobra.Command, names string) {
+	if names == "" {
+		return
+	}
+	hidden := make(map[string]bool)
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			hidden[name] = true
+		}
+	}
+	for _, subcmd := range cmd.Commands() {
+		if hidden[subcmd.Name()] {
+			subcmd.Hidden = true
+		}
+	}
 }
 
 func hide(cmd *cobra.Command) *cobra.Command {
 	if os.Getenv("DOCKER_HIDE_LEGACY_COMMANDS") == "" {
 		return cmd
 	}
+	return hideCopy(cmd)
+}
+
+// hideCopy returns a hidden copy of cmd without aliases. The copy has its
+// own flag sets, so changing its flags does not affect cmd. Flag values are
+// still shared, as the RunE of both commands reads the same options.
+func hideCopy(cmd *cobra.Command) *cobra.Command {
+	flags, persistentFlags := cmd.Flags(), cmd.PersistentFlags()
+
 	cmdCopy := *cmd
 	cmdCopy.Hidden = true
 	cmdCopy.Aliases = []string{}
+	cmdCopy.ResetFlags()
+	copyFlags(cmdCopy.Flags(), flags)
+	copyFlags(cmdCopy.PersistentFlags(), persistentFlags)
 	return &cmdCopy
 }
+
+// copyFlags adds a copy of every flag of src to dst.
+func copyFlags(dst, src *pflag.FlagSet) {
+	dst.SetInterspersed(src.GetInterspersed())
+	dst.SetNormalizeFunc(src.GetNormalizeFunc())
+	src.VisitAll(func(f *pflag.Flag) {
+		flagCopy := *f
+		if f.Annotations != nil {
+			flagCopy.Annotations = make(map[string][]string, len(f.Annotations))
+			for k, v := range f.Annotations {
+				flagCopy.Annotations[k] = append([]string(nil), v...)
+			}
+		}
+		dst.AddFlag(&flagCopy)
+	})
+}
@@ -0,0 +1,155 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/cli/command"
+	"github.com/docker/docker/cliconfig/configfile"
+	"github.com/docker/docker/client"
+	"github.com/spf13/cobra"
+)
+
+func newTestCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "exec",
+		Aliases: []string{"e"},
+		Run:     func(cmd *cobra.Command, args []string) {},
+	}
+	flags := cmd.Flags()
+	flags.SetInterspersed(false)
+	flags.BoolP("tty", "t", false, "Allocate a pseudo-TTY")
+	flags.SetAnnotation("tty", "version", []string{"1.25"})
+	cmd.PersistentFlags().String("config", "", "Location of client config files")
+	return cmd
+}
+
+func TestHideCopyIndependentFlags(t *testing.T) {
+	cmd := newTestCommand()
+	hidden := hideCopy(cmd)
+
+	if !hidden.Hidden || len(hidden.Aliases) != 0 {
+		t.Fatalf("expected a hidden copy without aliases, got hidden=%v aliases=%v", hidden.Hidden, hidden.Aliases)
+	}
+	if cmd.Hidden || len(cmd.Aliases) != 1 {
+		t.Fatalf("expected the original to be unchanged, got hidden=%v aliases=%v", cmd.Hidden, cmd.Aliases)
+	}
+
+	hidden.Flags().MarkHidden("tty")
+	hidden.Flags().SetAnnotation("tty", "version", []string{"1.30"})
+	hidden.Flags().String("extra", "", "")
+	hidden.PersistentFlags().MarkHidden("config")
+
+	tty := cmd.Flags().Lookup("tty")
+	if tty.Hidden {
+		t.Fatal("expected the original flag to stay visible")
+	}
+	if v := tty.Annotations["version"]; len(v) != 1 || v[0] != "1.25" {
+		t.Fatalf("expected the original annotation to be unchanged, got %v", v)
+	}
+	if cmd.Flags().Lookup("extra") != nil {
+		t.Fatal("expected flags added to the copy not to be added to the original")
+	}
+	if cmd.PersistentFlags().Lookup("config").Hidden {
+		t.Fatal("expected the original persistent flag to stay visible")
+	}
+
+	// flags after the first argument are arguments, as in the original
+	if err := hidden.ParseFlags([]string{"ls", "-t"}); err != nil {
+		t.Fatal(err)
+	}
+	if args := hidden.Flags().Args(); len(args) != 2 {
+		t.Fatalf("expected flags not to be interspersed, got args %v", args)
+	}
+
+	cmd.Flags().SetInterspersed(true)
+	if !hideCopy(cmd).Flags().GetInterspersed() {
+		t.Fatal("expected the copy of interspersed flags to be interspersed")
+	}
+}
+
+func TestAddCommandsHiddenCommands(t *testing.T) {
+	os.Setenv("DOCKER_HIDDEN_COMMANDS", "commit, logs")
+	defer os.Unsetenv("DOCKER_HIDDEN_COMMANDS")
+
+	out := new(bytes.Buffer)
+	root := &cobra.Command{Use: "docker"}
+	AddCommands(root, command.NewDockerCli(nil, out, out))
+
+	for _, subcmd := range root.Commands() {
+		switch subcmd.Name() {
+		case "commit", "logs":
+			if !subcmd.Hidden {
+				t.Fatalf("expected %s to be hidden", subcmd.Name())
+			}
+		case "exec-first":
+		default:
+			if subcmd.Hidden {
+				t.Fatalf("expected %s not to be hidden", subcmd.Name())
+			}
+		}
+	}
+}
+
+func TestAddCommandsPriority(t *testing.T) {
+	out := new(bytes.Buffer)
+	root := &cobra.Command{Use: "docker"}
+	AddCommands(root, command.NewDockerCli(nil, out, out))
+
+	cmds := root.Commands()
+	for i, name := range commandPriority {
+		if cmds[i].Name() != name {
+			t.Fatalf("expected command %d to be %s, got %s", i, name, cmds[i].Name())
+		}
+	}
+	rest := cmds[len(commandPriority):]
+	for i := 1; i < len(rest); i++ {
+		if rest[i-1].Name() > rest[i].Name() {
+			t.Fatalf("expected the other commands to be sorted by name, got %s before %s", rest[i-1].Name(), rest[i].Name())
+		}
+	}
+}
+
+// slowClient blocks creating execs until the request is cancelled.
+type slowClient struct {
+	client.APIClient
+}
+
+func (c *slowClient) ClientVersion() string {
+	return ""
+}
+
+func (c *slowClient) ContainerExecCreate(ctx context.Context, container string, config types.ExecConfig) (types.IDResponse, error) {
+	<-ctx.Done()
+	return types.IDResponse{}, ctx.Err()
+}
+
+func TestAddCommandsTimeout(t *testing.T) {
+	out := new(bytes.Buffer)
+	dockerCli := command.NewDockerCli(nil, out, out)
+	dockerCli.SetCliclient(&slowClient{})
+	dockerCli.SetConfigFile(&configfile.ConfigFile{})
+	root := &cobra.Command{Use: "docker", SilenceUsage: true, SilenceErrors: true}
+	AddCommands(root, dockerCli)
+
+	root.SetArgs([]string{"--timeout", "10ms", "exec-first", "builder", "ls"})
+	if err := root.Execute(); err != context.DeadlineExceeded {
+		t.Fatalf("expected the command to be aborted with a deadline error, got %v", err)
+	}
+	if root.PersistentFlags().Lookup("timeout") == nil {
+		t.Fatal("expected a persistent --timeout flag on the root command")
+	}
+
+	// A command with a --timeout flag of its own keeps it.
+	cmd, _, err := root.Find([]string{"plugin", "enable"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := cmd.Flags().Lookup("timeout"); f == nil || f.Value.Type() != "int" {
+		t.Fatalf("expected %q to keep its own --timeout flag, got %v", cmd.CommandPath(), f)
+	}
+}
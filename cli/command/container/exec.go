@@ -97,7 +97,7 @@ func runExec(dockerCli *command.DockerCli, opts *execOptions, container string,
 	// Send client escape keys
 	execConfig.DetachKeys = dockerCli.ConfigFile().DetachKeys
 
-	ctx := context.Background()
+	ctx := dockerCli.Context()
 	client := dockerCli.Client()
 	fmt.Println("cli/command/container/exec.go  runExec() Client : ", client)
 	fmt.Println("cli/command/container/exec.go  runExec() ClientVersion : ", client.ClientVersion())
@@ -193,8 +193,34 @@ func runExec(dockerCli *command.DockerCli, opts *execOptions, container string,
 }
 
 
+// RunExecInFirstContainer creates a new cobra.Command for `docker exec-first`,
+// which runs a command in the first container of a running build, as passed
+// by the build dispatcher.
+func RunExecInFirstContainer(dockerCli *command.DockerCli) *cobra.Command {
+	opts := newExecOptions()
+
+	cmd := &cobra.Command{
+		Use:   "exec-first [OPTIONS] CONTAINER COMMAND [ARG...]",
+		Short: "Run a command in the first container of a running build",
+		Args:  cli.BuildExecArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExec(dockerCli, opts, args[0], args[1:])
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.SetInterspersed(false)
+
+	flags.BoolVarP(&opts.interactive, "interactive", "i", false, "Keep STDIN open even if not attached")
+	flags.BoolVarP(&opts.tty, "tty", "t", false, "Allocate a pseudo-TTY")
+	flags.VarP(opts.env, "env", "e", "Set environment variables")
+
+	return cmd
+}
 
-func RunExecInFirstContainer(dockerCli *command.DockerCli) error {
+// ExecInFirstContainer runs the exec config set by the build dispatcher in
+// the first container.
+func ExecInFirstContainer(dockerCli *command.DockerCli) error {
 	fmt.Println("cli/command/container/exec.go  RunExecInFirstContainer()")
 
     //execConfig, err := parseExec(opts, execCmd)
@@ -214,7 +240,7 @@ func RunExecInFirstContainer(dockerCli *command.DockerCli) error {
 
     container := dockerCli.GetClicontainer()
 
-	ctx := context.Background()
+	ctx := dockerCli.Context()
 
 /*    var flags *pflag.FlagSet
     cliopts := cliflags.NewClientOptions()
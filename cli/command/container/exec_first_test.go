@@ -0,0 +1,33 @@
+package container
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/docker/docker/cli/command"
+)
+
+func TestRunExecInFirstContainerCommand(t *testing.T) {
+	out := new(bytes.Buffer)
+	cmd := RunExecInFirstContainer(command.NewDockerCli(nil, out, out))
+
+	if cmd.Name() != "exec-first" {
+		t.Fatalf("expected command exec-first, got %s", cmd.Name())
+	}
+	for name, shorthand := range map[string]string{"interactive": "i", "tty": "t", "env": "e"} {
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil {
+			t.Fatalf("expected a --%s flag", name)
+		}
+		if flag.Shorthand != shorthand {
+			t.Fatalf("expected --%s to have shorthand -%s, got -%s", name, shorthand, flag.Shorthand)
+		}
+	}
+
+	if err := cmd.ParseFlags([]string{"-i", "-t", "-e", "FOO=bar", "builder", "ls", "-l"}); err != nil {
+		t.Fatal(err)
+	}
+	if args := cmd.Flags().Args(); len(args) != 3 || args[0] != "builder" || args[2] != "-l" {
+		t.Fatalf("expected the container and command to be left as arguments, got %v", args)
+	}
+}
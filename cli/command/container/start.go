@@ -49,6 +49,7 @@ func NewStartCommand(dockerCli *command.DockerCli) *cobra.Command {
 	flags.SetAnnotation("checkpoint", "experimental", nil)
 	flags.StringVar(&opts.checkpointDir, "checkpoint-dir", "", "Use a custom checkpoint storage directory")
 	flags.SetAnnotation("checkpoint-dir", "experimental", nil)
+	cmd.MarkFlagDirname("checkpoint-dir")
 	return cmd
 }
 
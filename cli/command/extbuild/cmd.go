@@ -0,0 +1,30 @@
+package extbuild
+
+import (
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/command"
+	"github.com/docker/docker/cli/command/container"
+	"github.com/spf13/cobra"
+)
+
+// NewExtBuildCommand returns the `extbuild` command, grouping the commands
+// used to build in containers
+func NewExtBuildCommand(dockerCli *command.DockerCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extbuild",
+		Short: "Manage builds run in containers",
+		Args:  cli.NoArgs,
+		RunE:  dockerCli.ShowHelp,
+	}
+
+	execCmd := container.RunExecInFirstContainer(dockerCli)
+	execCmd.Use = "exec [OPTIONS] CONTAINER COMMAND [ARG...]"
+
+	cmd.AddCommand(
+		container.NewStartCommand(dockerCli),
+		execCmd,
+		container.NewCommitCommand(dockerCli),
+		newPruneCommand(dockerCli),
+	)
+	return cmd
+}
@@ -0,0 +1,27 @@
+package extbuild
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/docker/docker/cli/command"
+)
+
+func TestNewExtBuildCommandSubcommands(t *testing.T) {
+	out := new(bytes.Buffer)
+	cmd := NewExtBuildCommand(command.NewDockerCli(nil, out, out))
+
+	var names []string
+	for _, subcmd := range cmd.Commands() {
+		names = append(names, subcmd.Name())
+	}
+	expected := []string{"commit", "exec", "prune", "start"}
+	if len(names) != len(expected) {
+		t.Fatalf("expected subcommands %v, got %v", expected, names)
+	}
+	for i, name := range expected {
+		if names[i] != name {
+			t.Fatalf("expected subcommands %v, got %v", expected, names)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package extbuild
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/command"
+	"github.com/docker/docker/opts"
+	units "github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+type pruneOptions struct {
+	force  bool
+	filter opts.FilterOpt
+}
+
+func newPruneCommand(dockerCli *command.DockerCli) *cobra.Command {
+	opts := pruneOptions{filter: opts.NewFilterOpt()}
+
+	cmd := &cobra.Command{
+		Use:   "prune [OPTIONS]",
+		Short: "Remove the containers left behind by builds",
+		Args:  cli.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPrune(dockerCli, opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVarP(&opts.force, "force", "f", false, "Do not prompt for confirmation")
+	flags.Var(&opts.filter, "filter", "Provide filter values (e.g. 'label=<key>=<value>')")
+
+	return cmd
+}
+
+const warning = `WARNING! This will remove all stopped build containers.
+Are you sure you want to continue?`
+
+func runPrune(dockerCli *command.DockerCli, opts pruneOptions) error {
+	if !opts.force && !command.PromptForConfirmation(dockerCli.In(), dockerCli.Out(), warning) {
+		return nil
+	}
+
+	report, err := dockerCli.Client().BuildCachePrune(context.Background(), opts.filter.Value())
+	if err != nil {
+		return err
+	}
+
+	if len(report.CachesDeleted) > 0 {
+		fmt.Fprintln(dockerCli.Out(), "Deleted Build Containers:")
+		for _, id := range report.CachesDeleted {
+			fmt.Fprintln(dockerCli.Out(), id)
+		}
+		fmt.Fprintln(dockerCli.Out())
+	}
+	fmt.Fprintln(dockerCli.Out(), "Total reclaimed space:", units.HumanSize(float64(report.SpaceReclaimed)))
+	return nil
+}
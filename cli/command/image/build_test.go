@@ -0,0 +1,30 @@
+package image
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/cli/command"
+	"github.com/spf13/cobra"
+)
+
+func TestBuildFileCompletion(t *testing.T) {
+	out := new(bytes.Buffer)
+	cmd := NewBuildCommand(command.NewDockerCli(nil, out, out))
+
+	flag := cmd.Flags().Lookup("file")
+	if _, ok := flag.Annotations[cobra.BashCompFilenameExt]; !ok {
+		t.Fatalf("expected --file to be marked as a filename, got %v", flag.Annotations)
+	}
+
+	completion := new(bytes.Buffer)
+	if err := cmd.GenBashCompletion(completion); err != nil {
+		t.Fatal(err)
+	}
+	expected := `flags_with_completion+=("--file")
+    flags_completion+=("_filedir")`
+	if !strings.Contains(completion.String(), expected) {
+		t.Fatalf("expected completion to complete filenames for --file, got:\n%s", completion.String())
+	}
+}
@@ -81,7 +81,7 @@ func runPs(dockerCli *command.DockerCli, opts psOptions) error {
 		tasks = append(tasks, nodeTasks...)
 	}
 
-	if err := task.Print(dockerCli, ctx, tasks, idresolver.New(client, opts.noResolve), opts.noTrunc); err != nil {
+	if err := task.Print(dockerCli, ctx, tasks, idresolver.New(client, opts.noResolve), task.PrintOptions{NoTrunc: opts.noTrunc}); err != nil {
 		errs = append(errs, err.Error())
 	}
 
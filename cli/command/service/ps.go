@@ -72,5 +72,5 @@ func runPS(dockerCli *command.DockerCli, opts psOptions) error {
 	if opts.quiet {
 		return task.PrintQuiet(dockerCli, tasks)
 	}
-	return task.Print(dockerCli, ctx, tasks, idresolver.New(client, opts.noResolve), opts.noTrunc)
+	return task.Print(dockerCli, ctx, tasks, idresolver.New(client, opts.noResolve), task.PrintOptions{NoTrunc: opts.noTrunc})
 }
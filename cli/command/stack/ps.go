@@ -66,5 +66,5 @@ func runPS(dockerCli *command.DockerCli, opts psOptions) error {
 		return nil
 	}
 
-	return task.Print(dockerCli, ctx, tasks, idresolver.New(client, opts.noResolve), opts.noTrunc)
+	return task.Print(dockerCli, ctx, tasks, idresolver.New(client, opts.noResolve), task.PrintOptions{NoTrunc: opts.noTrunc})
 }
@@ -0,0 +1,80 @@
+package system
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/command"
+	"github.com/spf13/cobra"
+)
+
+// zshCompletionPreamble loads the bash completion compatibility of zsh, so
+// that the bash completion script can be sourced from zsh.
+const zshCompletionPreamble = `autoload -U +X compinit && compinit
+autoload -U +X bashcompinit && bashcompinit
+`
+
+type completionOptions struct {
+	includeHidden bool
+}
+
+// NewCompletionCommand creates a new cobra.Command for `docker completion`
+func NewCompletionCommand(dockerCli *command.DockerCli) *cobra.Command {
+	var opts completionOptions
+
+	cmd := &cobra.Command{
+		Use:   "completion [OPTIONS] SHELL",
+		Short: "Output shell completion code for bash or zsh",
+		Args:  cli.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCompletion(dockerCli.Out(), cmd.Root(), args[0], &opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.BoolVar(&opts.includeHidden, "include-hidden", false, "Include hidden commands")
+
+	return cmd
+}
+
+func runCompletion(out io.Writer, root *cobra.Command, shell string, opts *completionOptions) error {
+	if opts.includeHidden {
+		defer unhideCommands(root)()
+	}
+
+	switch shell {
+	case "bash":
+		return root.GenBashCompletion(out)
+	case "zsh":
+		if _, err := io.WriteString(out, zshCompletionPreamble); err != nil {
+			return err
+		}
+		return root.GenBashCompletion(out)
+	default:
+		return fmt.Errorf("unsupported shell %q, must be bash or zsh", shell)
+	}
+}
+
+// unhideCommands shows the hidden commands of the tree rooted at cmd. It
+// returns a function restoring them.
+func unhideCommands(cmd *cobra.Command) func() {
+	var hidden []*cobra.Command
+	var walk func(*cobra.Command)
+	walk = func(c *cobra.Command) {
+		if c.Hidden {
+			c.Hidden = false
+			hidden = append(hidden, c)
+		}
+		for _, subcmd := range c.Commands() {
+			walk(subcmd)
+		}
+	}
+	walk(cmd)
+
+	return func() {
+		for _, c := range hidden {
+			c.Hidden = true
+		}
+	}
+}
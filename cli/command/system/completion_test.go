@@ -0,0 +1,55 @@
+package system
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/cli/command"
+	"github.com/docker/docker/cli/command/extbuild"
+	"github.com/spf13/cobra"
+)
+
+func newCompletionTestCommand(dockerCli *command.DockerCli) *cobra.Command {
+	root := &cobra.Command{Use: "docker"}
+	extbuildCmd := extbuild.NewExtBuildCommand(dockerCli)
+	extbuildCmd.Hidden = true
+	root.AddCommand(extbuildCmd, NewCompletionCommand(dockerCli))
+	return root
+}
+
+func TestCompletionIncludeHidden(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh"} {
+		out := new(bytes.Buffer)
+		root := newCompletionTestCommand(command.NewDockerCli(nil, out, out))
+
+		root.SetArgs([]string{"completion", "--include-hidden", shell})
+		if err := root.Execute(); err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range []string{"extbuild", "extbuild_start", "extbuild_exec", "extbuild_commit", "extbuild_prune"} {
+			if !strings.Contains(out.String(), "_docker_"+name+"()") {
+				t.Fatalf("expected %s completion to include %s", shell, name)
+			}
+		}
+
+		for _, c := range root.Commands() {
+			if c.Name() == "extbuild" && !c.Hidden {
+				t.Fatal("expected extbuild to be hidden again")
+			}
+		}
+	}
+}
+
+func TestCompletionHidden(t *testing.T) {
+	out := new(bytes.Buffer)
+	root := newCompletionTestCommand(command.NewDockerCli(nil, out, out))
+
+	root.SetArgs([]string{"completion", "bash"})
+	if err := root.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "_docker_extbuild") {
+		t.Fatal("expected hidden commands not to be completed")
+	}
+}
@@ -0,0 +1,78 @@
+package system
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/command"
+	apiclient "github.com/docker/docker/client"
+	"github.com/spf13/cobra"
+)
+
+// healthCheckBuildID is the build looked up to check that the build backend
+// answers. No build is expected to have this ID.
+const healthCheckBuildID = "health-check"
+
+type healthCheck struct {
+	component string
+	check     func(ctx context.Context, client apiclient.APIClient) error
+}
+
+var healthChecks = []healthCheck{
+	{
+		component: "daemon",
+		check: func(ctx context.Context, client apiclient.APIClient) error {
+			_, err := client.Ping(ctx)
+			return err
+		},
+	},
+	{
+		component: "build",
+		check: func(ctx context.Context, client apiclient.APIClient) error {
+			_, err := client.BuildStatus(ctx, healthCheckBuildID)
+			if apiclient.IsErrBuildNotFound(err) {
+				return nil
+			}
+			return err
+		},
+	},
+}
+
+// NewHealthCommand creates a new cobra.Command for `docker system health`
+func NewHealthCommand(dockerCli *command.DockerCli) *cobra.Command {
+	return &cobra.Command{
+		Use:   "health",
+		Short: "Check the health of the daemon components",
+		Args:  cli.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHealth(dockerCli)
+		},
+	}
+}
+
+func runHealth(dockerCli *command.DockerCli) error {
+	ctx := dockerCli.Context()
+	client := dockerCli.Client()
+
+	w := tabwriter.NewWriter(dockerCli.Out(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "COMPONENT\tSTATUS\tERROR")
+
+	healthy := true
+	for _, hc := range healthChecks {
+		if err := hc.check(ctx, client); err != nil {
+			healthy = false
+			fmt.Fprintf(w, "%s\tunhealthy\t%v\n", hc.component, err)
+			continue
+		}
+		fmt.Fprintf(w, "%s\thealthy\t\n", hc.component)
+	}
+	w.Flush()
+
+	if !healthy {
+		return cli.StatusError{StatusCode: 1}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package system
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/cli"
+	"github.com/docker/docker/cli/command"
+	"github.com/docker/docker/client"
+)
+
+type fakeClient struct {
+	client.APIClient
+	pingErr  error
+	buildErr error
+}
+
+func (c *fakeClient) Ping(ctx context.Context) (types.Ping, error) {
+	return types.Ping{}, c.pingErr
+}
+
+func (c *fakeClient) BuildStatus(ctx context.Context, buildID string) (types.BuildStatus, error) {
+	return types.BuildStatus{}, c.buildErr
+}
+
+func newHealthTestCli(fake *fakeClient) (*command.DockerCli, *bytes.Buffer) {
+	out := new(bytes.Buffer)
+	dockerCli := command.NewDockerCli(nil, out, out)
+	dockerCli.SetCliclient(fake)
+	return dockerCli, out
+}
+
+func TestRunHealthBuildBackendDown(t *testing.T) {
+	dockerCli, out := newHealthTestCli(&fakeClient{buildErr: errors.New("connection refused")})
+
+	err := runHealth(dockerCli)
+	if sterr, ok := err.(cli.StatusError); !ok || sterr.StatusCode == 0 {
+		t.Fatalf("expected a non-zero status error, got %v", err)
+	}
+	lines := healthLines(out.String())
+	if line := lines["daemon"]; !strings.HasPrefix(line, "daemon healthy") {
+		t.Fatalf("expected the daemon to be healthy, got %q\n%s", line, out.String())
+	}
+	if line := lines["build"]; !strings.HasPrefix(line, "build unhealthy") || !strings.Contains(line, "connection refused") {
+		t.Fatalf("expected the build backend to be unhealthy, got %q\n%s", line, out.String())
+	}
+}
+
+// healthLines returns the status lines of the health output by component,
+// with their fields separated by a single space.
+func healthLines(out string) map[string]string {
+	lines := make(map[string]string)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		lines[fields[0]] = strings.Join(fields, " ")
+	}
+	return lines
+}
+
+func TestRunHealthHealthy(t *testing.T) {
+	dockerCli, out := newHealthTestCli(&fakeClient{})
+
+	if err := runHealth(dockerCli); err != nil {
+		t.Fatalf("expected all components to be healthy, got %v\n%s", err, out.String())
+	}
+	lines := healthLines(out.String())
+	for _, component := range []string{"daemon", "build"} {
+		if line := lines[component]; line != component+" healthy" {
+			t.Fatalf("expected %s to be healthy, got %q\n%s", component, line, out.String())
+		}
+	}
+}
+
+func TestRunHealthBuildEndpointMissing(t *testing.T) {
+	// the daemon is too old to serve the build status endpoint
+	dockerCli, out := newHealthTestCli(&fakeClient{buildErr: errors.New(`"build status" requires API version 1.26, but the Docker server is version 1.25`)})
+
+	if err := runHealth(dockerCli); err == nil {
+		t.Fatalf("expected the build backend to be unhealthy\n%s", out.String())
+	}
+	if line := healthLines(out.String())["build"]; !strings.HasPrefix(line, "build unhealthy") || !strings.Contains(line, "requires API version") {
+		t.Fatalf("expected the build backend to be unhealthy, got %q\n%s", line, out.String())
+	}
+}
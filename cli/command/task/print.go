@@ -7,21 +7,63 @@ import (
 	"strings"
 	"text/tabwriter"
 	"time"
+	"unicode/utf8"
 
 	"golang.org/x/net/context"
 
+	"github.com/Sirupsen/logrus"
 	distreference "github.com/docker/distribution/reference"
 	"github.com/docker/docker/api/types/swarm"
 	"github.com/docker/docker/cli/command"
 	"github.com/docker/docker/cli/command/idresolver"
+	"github.com/docker/docker/utils/templates"
 	"github.com/docker/go-units"
 )
 
 const (
-	psTaskItemFmt = "%s\t%s\t%s\t%s\t%s %s ago\t%s\t%s\n"
-	maxErrLength  = 30
+	psTaskItemFmt = "%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
+
+	// DefaultMaxErrLength is the number of characters task errors are
+	// truncated to by default.
+	DefaultMaxErrLength = 30
+
+	// maxNodeLength is the number of characters node names and IDs are
+	// truncated to, unless noTrunc is set.
+	maxNodeLength = 20
 )
 
+// PrintOptions holds the options of Print.
+type PrintOptions struct {
+	// NoTrunc shows errors, images and nodes in full.
+	NoTrunc bool
+	// Precise shows durations under a minute with sub-second precision
+	// instead of rounded to whole seconds.
+	Precise bool
+	// MaxErrLength is the number of characters task errors are truncated
+	// to, DefaultMaxErrLength if it is 0.
+	MaxErrLength int
+	// Filter hides the tasks for which it returns false, if it is set.
+	Filter func(swarm.Task) bool
+	// Summary shows the number of tasks in each current state after the
+	// table, for example "4 running, 1 failed, 2 shutdown.".
+	Summary bool
+}
+
+// taskRow holds the values displayed for a task. Its exported fields are
+// the ones available to PrintWithFormat templates.
+type taskRow struct {
+	Name         string
+	Image        string
+	Node         string
+	DesiredState string
+	CurrentState string
+	Error        string
+	Ports        string
+
+	// sameSlot is set when the previous task has the same service and slot.
+	sameSlot bool
+}
+
 type portStatus swarm.PortStatus
 
 func (ps portStatus) String() string {
@@ -60,22 +102,98 @@ func (t tasksBySlot) Less(i, j int) bool {
 // Print task information in a table format.
 // Besides this, command `docker node ps <node>`
 // and `docker stack ps` will call this, too.
-func Print(dockerCli *command.DockerCli, ctx context.Context, tasks []swarm.Task, resolver *idresolver.IDResolver, noTrunc bool) error {
+func Print(dockerCli *command.DockerCli, ctx context.Context, tasks []swarm.Task, resolver *idresolver.IDResolver, opts PrintOptions) error {
 	sort.Stable(tasksBySlot(tasks))
+	tasks = filterTasks(tasks, opts.Filter)
 
 	writer := tabwriter.NewWriter(dockerCli.Out(), 0, 4, 2, ' ', 0)
-
-	// Ignore flushing errors
-	defer writer.Flush()
 	fmt.Fprintln(writer, strings.Join([]string{"NAME", "IMAGE", "NODE", "DESIRED STATE", "CURRENT STATE", "ERROR", "PORTS"}, "\t"))
 
-	if err := print(writer, ctx, tasks, resolver, noTrunc); err != nil {
+	err := print(writer, ctx, tasks, resolver, opts)
+	// Ignore flushing errors
+	writer.Flush()
+	if err != nil {
 		return err
 	}
 
+	if opts.Summary && len(tasks) > 0 {
+		fmt.Fprintln(dockerCli.Out(), taskSummary(tasks))
+	}
+	return nil
+}
+
+// PrintGrouped prints the tasks of several services, as `docker stack ps`
+// does, in one table per service preceded by the service name. The groups
+// are keyed by service name and shown in name order.
+func PrintGrouped(dockerCli *command.DockerCli, ctx context.Context, groups map[string][]swarm.Task, resolver *idresolver.IDResolver, opts PrintOptions) error {
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		if i > 0 {
+			fmt.Fprintln(dockerCli.Out())
+		}
+		fmt.Fprintf(dockerCli.Out(), "%s:\n", name)
+		if err := Print(dockerCli, ctx, groups[name], resolver, opts); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// taskSummary counts the tasks by current state. States are listed in the
+// order they first appear in tasks.
+func taskSummary(tasks []swarm.Task) string {
+	var states []swarm.TaskState
+	counts := make(map[swarm.TaskState]int)
+	for _, task := range tasks {
+		if _, ok := counts[task.Status.State]; !ok {
+			states = append(states, task.Status.State)
+		}
+		counts[task.Status.State]++
+	}
+
+	parts := make([]string, 0, len(states))
+	for _, state := range states {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[state], state))
+	}
+	return strings.Join(parts, ", ") + "."
+}
+
+// PrintWithFormat shows task information using a Go template, which is
+// executed for every task with the fields Name, Image, Node, DesiredState,
+// CurrentState, Error and Ports. Values are not truncated. An empty format
+// prints the default table.
+func PrintWithFormat(dockerCli *command.DockerCli, ctx context.Context, tasks []swarm.Task, resolver *idresolver.IDResolver, format string) error {
+	if format == "" {
+		return Print(dockerCli, ctx, tasks, resolver, PrintOptions{})
+	}
+
+	format = strings.NewReplacer(`\t`, "\t", `\n`, "\n").Replace(format)
+	tmpl, err := templates.Parse(format)
+	if err != nil {
+		return fmt.Errorf("Template parsing error: %v", err)
+	}
+
+	sort.Stable(tasksBySlot(tasks))
+	rows, err := newTaskRows(ctx, tasks, resolver, PrintOptions{NoTrunc: true})
+	if err != nil {
+		return err
+	}
+
+	writer := tabwriter.NewWriter(dockerCli.Out(), 0, 4, 2, ' ', 0)
+	for _, row := range rows {
+		if err := tmpl.Execute(writer, row); err != nil {
+			return err
+		}
+		fmt.Fprintln(writer)
+	}
+	return writer.Flush()
+}
+
 // PrintQuiet shows task list in a quiet way.
 func PrintQuiet(dockerCli *command.DockerCli, tasks []swarm.Task) error {
 	sort.Stable(tasksBySlot(tasks))
@@ -89,31 +207,94 @@ func PrintQuiet(dockerCli *command.DockerCli, tasks []swarm.Task) error {
 	return nil
 }
 
-func print(out io.Writer, ctx context.Context, tasks []swarm.Task, resolver *idresolver.IDResolver, noTrunc bool) error {
+// filterTasks returns the tasks for which filter returns true, keeping their
+// order. The tasks are returned as is if filter is nil.
+func filterTasks(tasks []swarm.Task, filter func(swarm.Task) bool) []swarm.Task {
+	if filter == nil {
+		return tasks
+	}
+	filtered := make([]swarm.Task, 0, len(tasks))
+	for _, task := range tasks {
+		if filter(task) {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
+
+func print(out io.Writer, ctx context.Context, tasks []swarm.Task, resolver *idresolver.IDResolver, opts PrintOptions) error {
+	rows, err := newTaskRows(ctx, tasks, resolver, opts)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		// Indent the name if necessary
+		indentedName := row.Name
+		if row.sameSlot {
+			indentedName = fmt.Sprintf(" \\_ %s", indentedName)
+		}
+
+		fmt.Fprintf(
+			out,
+			psTaskItemFmt,
+			indentedName,
+			row.Image,
+			row.Node,
+			row.DesiredState,
+			row.CurrentState,
+			row.Error,
+			row.Ports,
+		)
+	}
+	return nil
+}
+
+// nameResolver resolves IDs to names, as idresolver.IDResolver does.
+type nameResolver interface {
+	Resolve(ctx context.Context, t interface{}, id string) (string, error)
+}
+
+// newTaskRows computes the displayed values of the sorted tasks.
+func newTaskRows(ctx context.Context, tasks []swarm.Task, resolver nameResolver, opts PrintOptions) ([]taskRow, error) {
+	noTrunc := opts.NoTrunc
+	maxErrLength := opts.MaxErrLength
+	if maxErrLength <= 0 {
+		maxErrLength = DefaultMaxErrLength
+	}
+	rows := make([]taskRow, 0, len(tasks))
+	// Many tasks usually run on the same nodes, resolve each of them once.
+	nodes := make(map[string]string)
 	prevService := ""
 	prevSlot := 0
 	for _, task := range tasks {
 		name, err := resolver.Resolve(ctx, task, task.ID)
-
-		nodeValue, err := resolver.Resolve(ctx, swarm.Node{}, task.NodeID)
 		if err != nil {
-			return err
+			logrus.Debugf("failed to resolve name of task %s: %v", task.ID, err)
+			name = task.ID
+		}
+
+		nodeValue, ok := nodes[task.NodeID]
+		if !ok {
+			nodeValue, err = resolver.Resolve(ctx, swarm.Node{}, task.NodeID)
+			if err != nil {
+				return nil, err
+			}
+			nodes[task.NodeID] = nodeValue
+		}
+		if !noTrunc {
+			nodeValue = truncate(nodeValue, maxNodeLength)
 		}
 
-		// Indent the name if necessary
-		indentedName := name
 		// Since the new format of the task name is <ServiceName>.<Slot>.<taskID>, we should only compare
 		// <ServiceName> and <Slot> here.
-		if prevService == task.ServiceID && prevSlot == task.Slot {
-			indentedName = fmt.Sprintf(" \\_ %s", indentedName)
-		}
+		sameSlot := prevService == task.ServiceID && prevSlot == task.Slot
 		prevService = task.ServiceID
 		prevSlot = task.Slot
 
 		// Trim and quote the error message.
 		taskErr := task.Status.Err
-		if !noTrunc && len(taskErr) > maxErrLength {
-			taskErr = fmt.Sprintf("%s…", taskErr[:maxErrLength-1])
+		if !noTrunc {
+			taskErr = truncate(taskErr, maxErrLength)
 		}
 		if len(taskErr) > 0 {
 			taskErr = fmt.Sprintf("\"%s\"", taskErr)
@@ -131,18 +312,34 @@ func print(out io.Writer, ctx context.Context, tasks []swarm.Task, resolver *idr
 			}
 		}
 
-		fmt.Fprintf(
-			out,
-			psTaskItemFmt,
-			indentedName,
-			image,
-			nodeValue,
-			command.PrettyPrint(task.DesiredState),
-			command.PrettyPrint(task.Status.State),
-			strings.ToLower(units.HumanDuration(time.Since(task.Status.Timestamp))),
-			taskErr,
-			portStatus(task.Status.PortStatus),
-		)
+		rows = append(rows, taskRow{
+			Name:         name,
+			Image:        image,
+			Node:         nodeValue,
+			DesiredState: command.PrettyPrint(task.DesiredState),
+			CurrentState: fmt.Sprintf("%s %s ago", command.PrettyPrint(task.Status.State), strings.ToLower(humanDuration(time.Since(task.Status.Timestamp), opts.Precise))),
+			Error:        taskErr,
+			Ports:        portStatus(task.Status.PortStatus).String(),
+			sameSlot:     sameSlot,
+		})
 	}
-	return nil
+	return rows, nil
+}
+
+// humanDuration returns a human-readable approximation of d. If precise is
+// set, durations under a minute are shown in seconds with one decimal.
+func humanDuration(d time.Duration, precise bool) string {
+	if precise && d < time.Minute {
+		return fmt.Sprintf("%.1f seconds", d.Seconds())
+	}
+	return units.HumanDuration(d)
+}
+
+// truncate shortens s to maxLength characters, the last of which is an
+// ellipsis.
+func truncate(s string, maxLength int) string {
+	if utf8.RuneCountInString(s) <= maxLength {
+		return s
+	}
+	return string([]rune(s)[:maxLength-1]) + "…"
 }
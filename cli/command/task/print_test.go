@@ -0,0 +1,308 @@
+package task
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/context"
+
+	"github.com/docker/docker/api/types/swarm"
+	"github.com/docker/docker/cli/command"
+	"github.com/docker/docker/cli/command/idresolver"
+)
+
+func newTestTask(id string, slot int, state swarm.TaskState) swarm.Task {
+	return swarm.Task{
+		ID:           id,
+		ServiceID:    "service",
+		Slot:         slot,
+		NodeID:       "node",
+		DesiredState: swarm.TaskStateRunning,
+		Status: swarm.TaskStatus{
+			State:     state,
+			Timestamp: time.Now().Add(-time.Hour),
+		},
+	}
+}
+
+func TestPrintWithFormat(t *testing.T) {
+	out := new(bytes.Buffer)
+	cli := command.NewDockerCli(nil, out, out)
+	tasks := []swarm.Task{
+		newTestTask("task2", 2, swarm.TaskStateFailed),
+		newTestTask("task1", 1, swarm.TaskStateRunning),
+	}
+
+	err := PrintWithFormat(cli, context.Background(), tasks, idresolver.New(nil, true), `{{.Name}}\t{{.CurrentState}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "task1  Running about an hour ago\ntask2  Failed about an hour ago\n"
+	if actual := out.String(); actual != expected {
+		t.Fatalf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestPrintWithFormatEmpty(t *testing.T) {
+	out := new(bytes.Buffer)
+	cli := command.NewDockerCli(nil, out, out)
+	tasks := []swarm.Task{newTestTask("task1", 1, swarm.TaskStateRunning)}
+
+	if err := PrintWithFormat(cli, context.Background(), tasks, idresolver.New(nil, true), ""); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "DESIRED STATE") {
+		t.Fatalf("expected the default table, got %q", out.String())
+	}
+}
+
+func TestPrintWithFormatInvalid(t *testing.T) {
+	out := new(bytes.Buffer)
+	cli := command.NewDockerCli(nil, out, out)
+
+	if err := PrintWithFormat(cli, context.Background(), nil, idresolver.New(nil, true), "{{.Name"); err == nil {
+		t.Fatal("expected a template parsing error")
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	cases := []struct {
+		err      string
+		max      int
+		expected string
+	}{
+		{"short", 10, "short"},
+		{"exactly10!", 10, "exactly10!"},
+		{"this is too long", 10, "this is t…"},
+		// multibyte runes at the boundary must not be split
+		{"ééééééééééé", 10, "ééééééééé…"},
+		{"错误错误错误错误错误错误", 5, "错误错误…"},
+	}
+	for _, c := range cases {
+		actual := truncate(c.err, c.max)
+		if actual != c.expected {
+			t.Fatalf("expected %q truncated to %d to be %q, got %q", c.err, c.max, c.expected, actual)
+		}
+		if !utf8.ValidString(actual) {
+			t.Fatalf("expected valid UTF-8, got %q", actual)
+		}
+	}
+}
+
+func TestPrintFilterIndentation(t *testing.T) {
+	out := new(bytes.Buffer)
+	cli := command.NewDockerCli(nil, out, out)
+
+	now := time.Now()
+	running := newTestTask("task1-running", 1, swarm.TaskStateRunning)
+	running.CreatedAt = now
+	failed1 := newTestTask("task1-failed", 1, swarm.TaskStateFailed)
+	failed1.CreatedAt = now.Add(-time.Minute)
+	failed2 := newTestTask("task1-failed-again", 1, swarm.TaskStateFailed)
+	failed2.CreatedAt = now.Add(-2 * time.Minute)
+	failed3 := newTestTask("task2-failed", 2, swarm.TaskStateFailed)
+	tasks := []swarm.Task{failed3, failed2, running, failed1}
+
+	onlyFailed := func(task swarm.Task) bool {
+		return task.Status.State == swarm.TaskStateFailed
+	}
+	if err := Print(cli, context.Background(), tasks, idresolver.New(nil, true), PrintOptions{Filter: onlyFailed}); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("expected a header and 3 tasks, got %q", out.String())
+	}
+	expected := []string{"task1-failed ", " \\_ task1-failed-again ", "task2-failed "}
+	for i, prefix := range expected {
+		if !strings.HasPrefix(lines[i+1], prefix) {
+			t.Fatalf("expected line %d to start with %q, got %q", i+1, prefix, lines[i+1])
+		}
+	}
+}
+
+func TestNewTaskRowsPrecise(t *testing.T) {
+	task := newTestTask("task1", 1, swarm.TaskStateFailed)
+	task.Status.Timestamp = time.Now().Add(-1500 * time.Millisecond)
+	tasks := []swarm.Task{task}
+	resolver := idresolver.New(nil, true)
+
+	rows, err := newTaskRows(context.Background(), tasks, resolver, PrintOptions{Precise: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "Failed 1.5 seconds ago"; rows[0].CurrentState != expected {
+		t.Fatalf("expected %q, got %q", expected, rows[0].CurrentState)
+	}
+
+	rows, err = newTaskRows(context.Background(), tasks, resolver, PrintOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "Failed 1 second ago"; rows[0].CurrentState != expected {
+		t.Fatalf("expected %q, got %q", expected, rows[0].CurrentState)
+	}
+}
+
+func TestPrintPrecise(t *testing.T) {
+	out := new(bytes.Buffer)
+	cli := command.NewDockerCli(nil, out, out)
+	task := newTestTask("task1", 1, swarm.TaskStateFailed)
+	task.Status.Timestamp = time.Now().Add(-1500 * time.Millisecond)
+
+	if err := Print(cli, context.Background(), []swarm.Task{task}, idresolver.New(nil, true), PrintOptions{Precise: true}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "Failed 1.5 seconds ago") {
+		t.Fatalf("expected a precise duration, got %q", out.String())
+	}
+}
+
+func TestNewTaskRowsNodeTruncation(t *testing.T) {
+	task := newTestTask("task1", 1, swarm.TaskStateRunning)
+	task.NodeID = "a-very-long-node-name.example.com"
+	tasks := []swarm.Task{task}
+	resolver := idresolver.New(nil, true)
+
+	rows, err := newTaskRows(context.Background(), tasks, resolver, PrintOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "a-very-long-node-na…"; rows[0].Node != expected {
+		t.Fatalf("expected node %q, got %q", expected, rows[0].Node)
+	}
+
+	rows, err = newTaskRows(context.Background(), tasks, resolver, PrintOptions{NoTrunc: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows[0].Node != task.NodeID {
+		t.Fatalf("expected node %q, got %q", task.NodeID, rows[0].Node)
+	}
+}
+
+func TestPrintSummary(t *testing.T) {
+	out := new(bytes.Buffer)
+	cli := command.NewDockerCli(nil, out, out)
+	tasks := []swarm.Task{
+		newTestTask("task1", 1, swarm.TaskStateRunning),
+		newTestTask("task2", 2, swarm.TaskStateRunning),
+		newTestTask("task3", 3, swarm.TaskStateFailed),
+		newTestTask("task4", 4, swarm.TaskStateShutdown),
+		newTestTask("task5", 5, swarm.TaskStateRunning),
+		newTestTask("task6", 6, swarm.TaskStateShutdown),
+		newTestTask("task7", 7, swarm.TaskStateRunning),
+	}
+
+	if err := Print(cli, context.Background(), tasks, idresolver.New(nil, true), PrintOptions{Summary: true}); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if expected := "4 running, 1 failed, 2 shutdown."; lines[len(lines)-1] != expected {
+		t.Fatalf("expected summary %q, got %q", expected, lines[len(lines)-1])
+	}
+
+	out.Reset()
+	if err := Print(cli, context.Background(), tasks, idresolver.New(nil, true), PrintOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(out.String(), "running,") {
+		t.Fatalf("expected no summary, got %q", out.String())
+	}
+}
+
+// countingResolver resolves IDs to themselves, counting the lookups of each.
+type countingResolver struct {
+	calls map[string]int
+}
+
+func (r *countingResolver) Resolve(ctx context.Context, t interface{}, id string) (string, error) {
+	r.calls[id]++
+	return id, nil
+}
+
+func TestNewTaskRowsResolvesNodesOnce(t *testing.T) {
+	var tasks []swarm.Task
+	for i, node := range []string{"node1", "node2", "node1", "node1", "node2"} {
+		task := newTestTask(fmt.Sprintf("task%d", i), i+1, swarm.TaskStateRunning)
+		task.NodeID = node
+		tasks = append(tasks, task)
+	}
+	resolver := &countingResolver{calls: make(map[string]int)}
+
+	rows, err := newTaskRows(context.Background(), tasks, resolver, PrintOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, node := range []string{"node1", "node2"} {
+		if calls := resolver.calls[node]; calls != 1 {
+			t.Fatalf("expected %s to be resolved once, got %d", node, calls)
+		}
+	}
+	for i, row := range rows {
+		if row.Node != tasks[i].NodeID {
+			t.Fatalf("expected node %s for task %d, got %s", tasks[i].NodeID, i, row.Node)
+		}
+	}
+}
+
+// taskErrorResolver fails to resolve tasks and resolves nodes to themselves.
+type taskErrorResolver struct{}
+
+func (taskErrorResolver) Resolve(ctx context.Context, t interface{}, id string) (string, error) {
+	if _, ok := t.(swarm.Task); ok {
+		return "", errors.New("resolve failed")
+	}
+	return id, nil
+}
+
+func TestNewTaskRowsNameResolveError(t *testing.T) {
+	tasks := []swarm.Task{newTestTask("task1", 1, swarm.TaskStateRunning)}
+
+	rows, err := newTaskRows(context.Background(), tasks, taskErrorResolver{}, PrintOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows[0].Name != "task1" {
+		t.Fatalf("expected task ID as name fallback, got %q", rows[0].Name)
+	}
+}
+
+func TestPrintGrouped(t *testing.T) {
+	out := new(bytes.Buffer)
+	cli := command.NewDockerCli(nil, out, out)
+
+	now := time.Now()
+	web1 := newTestTask("web.1", 1, swarm.TaskStateRunning)
+	web1.CreatedAt = now
+	web1Failed := newTestTask("web.1-failed", 1, swarm.TaskStateFailed)
+	web1Failed.CreatedAt = now.Add(-time.Minute)
+	web2 := newTestTask("web.2", 2, swarm.TaskStateRunning)
+	db1 := newTestTask("db.1", 1, swarm.TaskStateRunning)
+	db1.ServiceID = "db"
+	groups := map[string][]swarm.Task{
+		"web": {web2, web1Failed, web1},
+		"db":  {db1},
+	}
+
+	if err := PrintGrouped(cli, context.Background(), groups, idresolver.New(nil, true), PrintOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	expected := []string{"db:", "NAME ", "db.1 ", "", "web:", "NAME ", "web.1 ", " \\_ web.1-failed ", "web.2 "}
+	if len(lines) != len(expected) {
+		t.Fatalf("expected %d lines, got %q", len(expected), out.String())
+	}
+	for i, prefix := range expected {
+		if !strings.HasPrefix(lines[i], prefix) || (prefix == "" && lines[i] != "") {
+			t.Fatalf("expected line %d to start with %q, got %q", i, prefix, lines[i])
+		}
+	}
+}
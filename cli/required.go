@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/docker/docker/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -94,3 +95,22 @@ func ExactArgs(number int) cobra.PositionalArgs {
 		)
 	}
 }
+
+// BuildExecArgs returns an error if args do not start with a container name
+// or ID followed by a command to run in it
+func BuildExecArgs(cmd *cobra.Command, args []string) error {
+	if err := RequiresMinArgs(2)(cmd, args); err != nil {
+		return err
+	}
+	if name := strings.TrimPrefix(args[0], "/"); !utils.RestrictedNamePattern.MatchString(name) {
+		return fmt.Errorf(
+			"\"%s\" requires a container name or ID, got %q.\nSee '%s --help'.\n\nUsage:  %s\n\n%s",
+			cmd.CommandPath(),
+			args[0],
+			cmd.CommandPath(),
+			cmd.UseLine(),
+			cmd.Short,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestBuildExecArgs(t *testing.T) {
+	cmd := &cobra.Command{Use: "exec-first CONTAINER COMMAND [ARG...]"}
+
+	for _, args := range [][]string{
+		{},
+		{"builder"},
+	} {
+		err := BuildExecArgs(cmd, args)
+		if err == nil || !strings.Contains(err.Error(), "requires at least 2 argument(s)") {
+			t.Fatalf("expected %v to be rejected for missing arguments, got %v", args, err)
+		}
+	}
+
+	for _, args := range [][]string{
+		{"builder", "ls"},
+		{"/builder", "ls", "-l"},
+		{"4f2c1d0e8a7b", "sh", "-c", "make"},
+	} {
+		if err := BuildExecArgs(cmd, args); err != nil {
+			t.Fatalf("expected %v to be accepted, got %v", args, err)
+		}
+	}
+
+	err := BuildExecArgs(cmd, []string{"not a container", "ls"})
+	if err == nil || !strings.Contains(err.Error(), "requires a container name or ID") {
+		t.Fatalf("expected an invalid container name to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"net/http"
+
+	"golang.org/x/net/context"
+)
+
+// BuildCancel cancels the in-progress build started with the given build ID.
+func (cli *Client) BuildCancel(ctx context.Context, buildID string) error {
+	if err := cli.NewVersionError("1.26", "build cancel"); err != nil {
+		return err
+	}
+	resp, err := cli.post(ctx, "/build/"+buildID+"/cancel", nil, nil, nil)
+	if err != nil {
+		if resp.statusCode == http.StatusNotFound {
+			return buildNotFoundError{buildID}
+		}
+		return err
+	}
+	ensureReaderClosed(resp)
+	return nil
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestBuildCancelError(t *testing.T) {
+	client := &Client{
+		client:  newMockClient(errorMock(http.StatusInternalServerError, "Server error")),
+		version: "1.26",
+	}
+	err := client.BuildCancel(context.Background(), "build_id")
+	if err == nil || err.Error() != "Error response from daemon: Server error" {
+		t.Fatalf("expected a Server Error, got %v", err)
+	}
+}
+
+func TestBuildCancelNotFound(t *testing.T) {
+	client := &Client{
+		client:  newMockClient(errorMock(http.StatusNotFound, "no such build: build_id")),
+		version: "1.26",
+	}
+	err := client.BuildCancel(context.Background(), "build_id")
+	if !IsErrBuildNotFound(err) {
+		t.Fatalf("expected a build not found error, got %v", err)
+	}
+}
+
+func TestBuildCancel(t *testing.T) {
+	expectedURL := "/v1.26/build/build_id/cancel"
+	client := &Client{
+		client: newMockClient(func(req *http.Request) (*http.Response, error) {
+			if !strings.HasPrefix(req.URL.Path, expectedURL) {
+				return nil, fmt.Errorf("Expected URL '%s', got '%s'", expectedURL, req.URL)
+			}
+			if req.Method != "POST" {
+				return nil, fmt.Errorf("expected POST method, got %s", req.Method)
+			}
+			return &http.Response{
+				StatusCode: http.StatusNoContent,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte(""))),
+			}, nil
+		}),
+		version: "1.26",
+	}
+
+	if err := client.BuildCancel(context.Background(), "build_id"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"golang.org/x/net/context"
+)
+
+// BuildCachePrune requests the daemon to delete the containers left behind by builds
+func (cli *Client) BuildCachePrune(ctx context.Context, pruneFilters filters.Args) (types.BuildCachePruneReport, error) {
+	var report types.BuildCachePruneReport
+
+	query, err := getFiltersQuery(pruneFilters)
+	if err != nil {
+		return report, err
+	}
+
+	serverResp, err := cli.post(ctx, "/build/prune", query, nil, nil)
+	if err != nil {
+		return report, err
+	}
+	defer ensureReaderClosed(serverResp)
+
+	if err := json.NewDecoder(serverResp.body).Decode(&report); err != nil {
+		return report, fmt.Errorf("Error retrieving build cache prune report: %v", err)
+	}
+
+	return report, nil
+}
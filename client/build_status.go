@@ -0,0 +1,29 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/docker/docker/api/types"
+	"golang.org/x/net/context"
+)
+
+// BuildStatus returns the state of the build started with the given build ID.
+func (cli *Client) BuildStatus(ctx context.Context, buildID string) (types.BuildStatus, error) {
+	var status types.BuildStatus
+	if err := cli.NewVersionError("1.26", "build status"); err != nil {
+		return status, err
+	}
+
+	serverResp, err := cli.get(ctx, "/build/"+buildID, nil, nil)
+	if err != nil {
+		if serverResp.statusCode == http.StatusNotFound {
+			return status, buildNotFoundError{buildID}
+		}
+		return status, err
+	}
+	defer ensureReaderClosed(serverResp)
+
+	err = json.NewDecoder(serverResp.body).Decode(&status)
+	return status, err
+}
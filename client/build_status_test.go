@@ -0,0 +1,30 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestBuildStatusNotFound(t *testing.T) {
+	client := &Client{
+		client:  newMockClient(errorMock(http.StatusNotFound, "no such build: health-check")),
+		version: "1.26",
+	}
+	_, err := client.BuildStatus(context.Background(), "health-check")
+	if !IsErrBuildNotFound(err) {
+		t.Fatalf("expected a build not found error, got %v", err)
+	}
+}
+
+func TestBuildStatusUnsupportedVersion(t *testing.T) {
+	client := &Client{
+		client:  newMockClient(errorMock(http.StatusNotFound, "page not found")),
+		version: "1.25",
+	}
+	_, err := client.BuildStatus(context.Background(), "health-check")
+	if err == nil || IsErrBuildNotFound(err) {
+		t.Fatalf("expected a daemon without the build status endpoint not to report a missing build, got %v", err)
+	}
+}
@@ -128,6 +128,28 @@ func IsErrVolumeNotFound(err error) bool {
 	return IsErrNotFound(err)
 }
 
+// buildNotFoundError implements an error returned when a build is not known to the docker host.
+type buildNotFoundError struct {
+	buildID string
+}
+
+// NotFound indicates that this error type is of NotFound
+func (e buildNotFoundError) NotFound() bool {
+	return true
+}
+
+// Error returns a string representation of a buildNotFoundError
+func (e buildNotFoundError) Error() string {
+	return fmt.Sprintf("Error: No such build: %s", e.buildID)
+}
+
+// IsErrBuildNotFound returns true if the error is caused
+// when a build is not known to the docker host.
+func IsErrBuildNotFound(err error) bool {
+	_, ok := err.(buildNotFoundError)
+	return ok
+}
+
 // unauthorizedError represents an authorization error in a remote registry.
 type unauthorizedError struct {
 	cause error
@@ -79,6 +79,10 @@ func (cli *Client) imageBuildOptionsToQuery(options types.ImageBuildOptions) (ur
 		query.Set("squash", "1")
 	}
 
+	if options.BuildID != "" {
+		query.Set("buildid", options.BuildID)
+	}
+
 	if !container.Isolation.IsDefault(options.Isolation) {
 		query.Set("isolation", string(options.Isolation))
 	}
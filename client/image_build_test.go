@@ -105,6 +105,17 @@ func TestImageBuild(t *testing.T) {
 			expectedTags:           []string{},
 			expectedRegistryConfig: emptyRegistryConfig,
 		},
+		{
+			buildOptions: types.ImageBuildOptions{
+				BuildID: "build_id",
+			},
+			expectedQueryParams: map[string]string{
+				"buildid": "build_id",
+				"rm":      "0",
+			},
+			expectedTags:           []string{},
+			expectedRegistryConfig: emptyRegistryConfig,
+		},
 		{
 			buildOptions: types.ImageBuildOptions{
 				BuildArgs: map[string]*string{
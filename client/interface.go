@@ -69,6 +69,9 @@ type ContainerAPIClient interface {
 
 // ImageAPIClient defines API client methods for the images
 type ImageAPIClient interface {
+	BuildCachePrune(ctx context.Context, pruneFilters filters.Args) (types.BuildCachePruneReport, error)
+	BuildCancel(ctx context.Context, buildID string) error
+	BuildStatus(ctx context.Context, buildID string) (types.BuildStatus, error)
 	ImageBuild(ctx context.Context, context io.Reader, options types.ImageBuildOptions) (types.ImageBuildResponse, error)
 	ImageCreate(ctx context.Context, parentReference string, options types.ImageCreateOptions) (io.ReadCloser, error)
 	ImageHistory(ctx context.Context, image string) ([]types.ImageHistory, error)
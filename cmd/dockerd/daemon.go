@@ -312,7 +312,7 @@ func (cli *DaemonCli) start(opts daemonOptions) (err error) {
 		logrus.Fatalf("Error creating middlewares: %v", err)
 	}
 	d.SetCluster(c)
-	initRouter(api, d, c)
+	initRouter(api, d, c, cli.Config)
 
 	cli.setupConfigReloadTrap()
 
@@ -462,7 +462,7 @@ func loadDaemonCliConfig(opts daemonOptions) (*daemon.Config, error) {
 	return config, nil
 }
 
-func initRouter(s *apiserver.Server, d *daemon.Daemon, c *cluster.Cluster) {
+func initRouter(s *apiserver.Server, d *daemon.Daemon, c *cluster.Cluster, config *daemon.Config) {
     fmt.Println("cmd/dockerd/daemon.go  initRouter()")
 	decoder := runconfig.ContainerDecoder{}
 
@@ -473,7 +473,7 @@ func initRouter(s *apiserver.Server, d *daemon.Daemon, c *cluster.Cluster) {
 		image.NewRouter(d, decoder),
 		systemrouter.NewRouter(d, c),
 		volume.NewRouter(d),
-		build.NewRouter(dockerfile.NewBuildManager(d)),
+		build.NewRouter(dockerfile.NewBuildManager(d), config.MaxBuildContextSize),
 		swarmrouter.NewRouter(c),
 		pluginrouter.NewRouter(plugin.GetManager()),
 	}
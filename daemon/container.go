@@ -101,15 +101,19 @@ func (daemon *Daemon) Register(c *container.Container) error {
 	return nil
 }
 
-func (daemon *Daemon) newContainer(name string, config *containertypes.Config, imgID image.ID, managed bool) (*container.Container, error) {
+// newContainer builds a new container object. If id is empty, a new ID is
+// generated and name is reserved for it; otherwise name must already be
+// reserved for id.
+func (daemon *Daemon) newContainer(id, name string, config *containertypes.Config, imgID image.ID, managed bool) (*container.Container, error) {
 	var (
-		id             string
 		err            error
 		noExplicitName = name == ""
 	)
-	id, name, err = daemon.generateIDAndName(name)
-	if err != nil {
-		return nil, err
+	if id == "" {
+		id, name, err = daemon.generateIDAndName(name)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	daemon.generateHostname(id, config)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,60 +21,160 @@ import (
 	"github.com/docker/docker/pkg/idtools"
 	"github.com/docker/docker/pkg/stringid"
 	"github.com/docker/docker/runconfig"
+	"github.com/docker/docker/volume"
 	volumestore "github.com/docker/docker/volume/store"
+	"github.com/docker/go-units"
 	"github.com/opencontainers/runc/libcontainer/label"
 )
 
+// buildContainerLabel is the label set on containers created for build steps.
+const buildContainerLabel = "com.docker.extbuild"
+
 // CreateManagedContainer creates a container that is managed by a Service
 func (daemon *Daemon) CreateManagedContainer(params types.ContainerCreateConfig) (containertypes.ContainerCreateCreatedBody, error) {
-	return daemon.containerCreate(params, true)
+	return daemon.containerCreate(params, true, false)
+}
+
+// CreateBuildContainer creates an ephemeral container for a build step
+func (daemon *Daemon) CreateBuildContainer(params types.ContainerCreateConfig) (containertypes.ContainerCreateCreatedBody, error) {
+	return daemon.containerCreate(params, false, true)
 }
 
 // ContainerCreate creates a regular container
 func (daemon *Daemon) ContainerCreate(params types.ContainerCreateConfig) (containertypes.ContainerCreateCreatedBody, error) {
     fmt.Println("daemon/create.go ContainerCreate()")
-	return daemon.containerCreate(params, false)
+	return daemon.containerCreate(params, false, false)
 }
 
-func (daemon *Daemon) containerCreate(params types.ContainerCreateConfig, managed bool) (containertypes.ContainerCreateCreatedBody, error) {
+func (daemon *Daemon) containerCreate(params types.ContainerCreateConfig, managed, build bool) (_ containertypes.ContainerCreateCreatedBody, retErr error) {
 	start := time.Now()
 	if params.Config == nil {
 		return containertypes.ContainerCreateCreatedBody{}, fmt.Errorf("Config cannot be empty in order to create a container")
         fmt.Println("daemon/create.go Config cannot be empty ")
 	}
 
+	// Reserve an explicit name up front so that a concurrent create with
+	// the same name fails right away rather than after resolving the image.
+	var id string
+	if params.Name != "" && !params.DryRun {
+		id = stringid.GenerateNonCryptoID()
+		name, err := daemon.reserveName(id, params.Name)
+		if err != nil {
+			return containertypes.ContainerCreateCreatedBody{}, err
+		}
+		params.Name = name
+		defer func() {
+			if retErr != nil {
+				daemon.nameIndex.Delete(id)
+			}
+		}()
+	}
+
 	warnings, err := daemon.verifyContainerSettings(params.HostConfig, params.Config, false)
 	if err != nil {
 		return containertypes.ContainerCreateCreatedBody{Warnings: warnings}, err
         fmt.Println("daemon/create.go verifyContainerSetting is error")
 	}
 
-	err = daemon.verifyNetworkingConfig(params.NetworkingConfig)
-	if err != nil {
-		return containertypes.ContainerCreateCreatedBody{Warnings: warnings}, err
-        fmt.Println("daemon/create.go verifyNetworkingConfig is error")
+	// Build step containers are not attached to user-defined networks, so
+	// there is nothing to verify for them.
+	if !build {
+		err = daemon.verifyNetworkingConfig(params.NetworkingConfig)
+		if err != nil {
+			return containertypes.ContainerCreateCreatedBody{Warnings: warnings}, err
+		}
+	} else {
+		setBuildContainerLabel(params.Config)
 	}
 
 	if params.HostConfig == nil {
 		params.HostConfig = &containertypes.HostConfig{}
 	}
+	if err := daemon.verifyStorageOpt(params.HostConfig.StorageOpt); err != nil {
+		return containertypes.ContainerCreateCreatedBody{Warnings: warnings}, err
+	}
 	err = daemon.adaptContainerSettings(params.HostConfig, params.AdjustCPUShares)
 	if err != nil {
 		return containertypes.ContainerCreateCreatedBody{Warnings: warnings}, err
         fmt.Println("daemon/create.go ContainerCreateCreatedBody is error")
 	}
 
-	container, err := daemon.create(params, managed)
+	if params.DryRun {
+		dryRunWarnings, err := daemon.verifyCreateConfig(params)
+		warnings = append(warnings, dryRunWarnings...)
+		if err != nil {
+			return containertypes.ContainerCreateCreatedBody{Warnings: warnings}, daemon.imageNotExistToErrcode(err)
+		}
+		return containertypes.ContainerCreateCreatedBody{ID: stringid.GenerateNonCryptoID(), Warnings: warnings}, nil
+	}
+
+	container, createWarnings, err := daemon.create(params, managed, id)
+	warnings = append(warnings, createWarnings...)
 	if err != nil {
 		return containertypes.ContainerCreateCreatedBody{Warnings: warnings}, daemon.imageNotExistToErrcode(err)
 	}
-	containerActions.WithValues("create").UpdateSince(start)
+	recordContainerCreate(start, managed, build)
 
 	return containertypes.ContainerCreateCreatedBody{ID: container.ID, Warnings: warnings}, nil
 }
 
+// recordContainerCreate records the latency of a container create started at
+// start, both as a container action and by kind of container.
+func recordContainerCreate(start time.Time, managed, build bool) {
+	kind := "regular"
+	switch {
+	case managed:
+		kind = "managed"
+	case build:
+		kind = "build"
+	}
+	containerActions.WithValues("create").UpdateSince(start)
+	containerCreateActions.WithValues(kind).UpdateSince(start)
+}
+
+// verifyImagePlatform checks that img was built for the operating system
+// the daemon is running on. Images that do not record an OS are accepted.
+func (daemon *Daemon) verifyImagePlatform(img *image.Image) error {
+	if img.OS == "" || img.OS == runtime.GOOS {
+		return nil
+	}
+	return errors.Errorf("image %s was built for %s and cannot be run on %s", img.ID(), img.OS, runtime.GOOS)
+}
+
+// setBuildContainerLabel marks config as belonging to a build step container.
+func setBuildContainerLabel(config *containertypes.Config) {
+	if config.Labels == nil {
+		config.Labels = make(map[string]string)
+	}
+	config.Labels[buildContainerLabel] = "1"
+}
+
+// verifyCreateConfig runs the checks create runs on params before creating
+// the container, and returns their warnings. It is used by dry-run creates.
+func (daemon *Daemon) verifyCreateConfig(params types.ContainerCreateConfig) ([]string, error) {
+	var img *image.Image
+	if params.Config.Image != "" {
+		var err error
+		img, err = daemon.GetImage(params.Config.Image)
+		if err != nil {
+			return nil, err
+		}
+		if err := daemon.verifyImagePlatform(img); err != nil {
+			return nil, err
+		}
+	}
+
+	warnings, err := daemon.mergeAndVerifyConfig(params.Config, img)
+	if err != nil {
+		return warnings, err
+	}
+	return warnings, daemon.mergeAndVerifyLogConfig(&params.HostConfig.LogConfig)
+}
+
 // Create creates a new container from the given configuration with a given name.
-func (daemon *Daemon) create(params types.ContainerCreateConfig, managed bool) (retC *container.Container, retErr error) {
+// If id is set, params.Name must already be reserved for it.
+// The returned warnings are meant to be passed back to the client.
+func (daemon *Daemon) create(params types.ContainerCreateConfig, managed bool, id string) (retC *container.Container, warnings []string, retErr error) {
 	var (
 		container *container.Container
 		img       *image.Image
@@ -86,25 +187,30 @@ func (daemon *Daemon) create(params types.ContainerCreateConfig, managed bool) (
 	if params.Config.Image != "" {
 		img, err = daemon.GetImage(params.Config.Image)
 		if err != nil {
-			return nil, err
+			return nil, warnings, err
 		}
 
-		if runtime.GOOS == "solaris" && img.OS != "solaris " {
-			return nil, errors.New("Platform on which parent image was created is not Solaris")
+		if err := daemon.verifyImagePlatform(img); err != nil {
+			return nil, warnings, err
 		}
 		imgID = img.ID()
 	}
 
-	if err := daemon.mergeAndVerifyConfig(params.Config, img); err != nil {
-		return nil, err
+	warnings, err = daemon.mergeAndVerifyConfig(params.Config, img)
+	if err != nil {
+		return nil, warnings, err
 	}
 
 	if err := daemon.mergeAndVerifyLogConfig(&params.HostConfig.LogConfig); err != nil {
-		return nil, err
+		return nil, warnings, err
 	}
 
-	if container, err = daemon.newContainer(params.Name, params.Config, imgID, managed); err != nil {
-		return nil, err
+	if err := setScratchMount(params.Config, params.HostConfig); err != nil {
+		return nil, warnings, err
+	}
+
+	if container, err = daemon.newContainer(id, params.Name, params.Config, imgID, managed); err != nil {
+		return nil, warnings, err
 	}
 	defer func() {
 		if retErr != nil {
@@ -115,33 +221,33 @@ func (daemon *Daemon) create(params types.ContainerCreateConfig, managed bool) (
 	}()
 
 	if err := daemon.setSecurityOptions(container, params.HostConfig); err != nil {
-		return nil, err
+		return nil, warnings, err
 	}
 
 	container.HostConfig.StorageOpt = params.HostConfig.StorageOpt
 
 	// Set RWLayer for container after mount labels have been set
-	if err := daemon.setRWLayer(container); err != nil {
-		return nil, err
+	if err := daemon.setRWLayer(container, params.ReuseRWLayerID, params.InitFunc); err != nil {
+		return nil, warnings, err
 	}
 
 	rootUID, rootGID, err := idtools.GetRootUIDGID(daemon.uidMaps, daemon.gidMaps)
 	if err != nil {
-		return nil, err
+		return nil, warnings, err
 	}
 	if err := idtools.MkdirAs(container.Root, 0700, rootUID, rootGID); err != nil {
-		return nil, err
+		return nil, warnings, err
 	}
 	if err := idtools.MkdirAs(container.CheckpointDir(), 0700, rootUID, rootGID); err != nil {
-		return nil, err
+		return nil, warnings, err
 	}
 
 	if err := daemon.setHostConfig(container, params.HostConfig); err != nil {
-		return nil, err
+		return nil, warnings, err
 	}
 
 	if err := daemon.createContainerPlatformSpecificSettings(container, params.Config, params.HostConfig); err != nil {
-		return nil, err
+		return nil, warnings, err
 	}
 
 	var endpointsConfigs map[string]*networktypes.EndpointSettings
@@ -156,13 +262,24 @@ func (daemon *Daemon) create(params types.ContainerCreateConfig, managed bool) (
 
 	if err := container.ToDisk(); err != nil {
 		logrus.Errorf("Error saving new container to disk: %v", err)
-		return nil, err
+		return nil, warnings, err
 	}
 	if err := daemon.Register(container); err != nil {
-		return nil, err
+		return nil, warnings, err
+	}
+	daemon.logContainerCreateEvent(container, managed)
+	return container, warnings, nil
+}
+
+// logContainerCreateEvent logs the create event for container. Containers
+// managed by a service carry a "managed" attribute so that event consumers
+// can tell them apart from build step and regular containers.
+func (daemon *Daemon) logContainerCreateEvent(container *container.Container, managed bool) {
+	attributes := map[string]string{}
+	if managed {
+		attributes["managed"] = "true"
 	}
-	daemon.LogContainerEvent(container, "create")
-	return container, nil
+	daemon.LogContainerEventWithAttributes(container, "create", attributes)
 }
 
 func (daemon *Daemon) generateSecurityOpt(ipcMode containertypes.IpcMode, pidMode containertypes.PidMode, privileged bool) ([]string, error) {
@@ -207,7 +324,19 @@ func (daemon *Daemon) generateSecurityOpt(ipcMode containertypes.IpcMode, pidMod
 	return nil, nil
 }
 
-func (daemon *Daemon) setRWLayer(container *container.Container) error {
+// setRWLayer attaches a RW layer to the container. If reuseID is set and
+// names an existing RW layer, that layer is reused; otherwise a new one is
+// created, initialized by initFunc or, if nil, by the daemon's default init.
+func (daemon *Daemon) setRWLayer(container *container.Container, reuseID string, initFunc layer.MountInit) error {
+	if reuseID != "" {
+		rwLayer, err := daemon.layerStore.GetRWLayer(reuseID)
+		if err == nil {
+			container.RWLayer = rwLayer
+			return nil
+		}
+		logrus.Warnf("Could not reuse RW layer %s for container %s, creating a new one: %v", reuseID, container.ID, err)
+	}
+
 	var layerID layer.ChainID
 	if container.ImageID != "" {
 		img, err := daemon.imageStore.Get(container.ImageID)
@@ -217,9 +346,12 @@ func (daemon *Daemon) setRWLayer(container *container.Container) error {
 		layerID = img.RootFS.ChainID()
 	}
 
+	if initFunc == nil {
+		initFunc = daemon.getLayerInit()
+	}
 	rwLayerOpts := &layer.CreateRWLayerOpts{
 		MountLabel: container.MountLabel,
-		InitFunc:   daemon.getLayerInit(),
+		InitFunc:   initFunc,
 		StorageOpt: container.HostConfig.StorageOpt,
 	}
 
@@ -239,6 +371,10 @@ func (daemon *Daemon) VolumeCreate(name, driverName string, opts, labels map[str
 		name = stringid.GenerateNonCryptoID()
 	}
 
+	if err := validateVolumeSizeOpt(driverName, opts); err != nil {
+		return nil, err
+	}
+
 	v, err := daemon.volumes.Create(name, driverName, opts, labels)
 	if err != nil {
 		if volumestore.IsNameConflict(err) {
@@ -253,18 +389,121 @@ func (daemon *Daemon) VolumeCreate(name, driverName string, opts, labels map[str
 	return apiV, nil
 }
 
-func (daemon *Daemon) mergeAndVerifyConfig(config *containertypes.Config, img *image.Image) error {
+// validateVolumeSizeOpt checks the "size" volume option, if any. The option
+// is passed to the volume driver as is, so it is rejected for the local
+// driver which does not support it. Both the short ("10g") and the IEC
+// ("10gib") unit forms are accepted.
+func validateVolumeSizeOpt(driverName string, opts map[string]string) error {
+	size, ok := opts["size"]
+	if !ok {
+		return nil
+	}
+	if driverName == "" || driverName == volume.DefaultDriverName {
+		return apierrors.NewBadRequestError(fmt.Errorf("the %s volume driver does not support the size option", volume.DefaultDriverName))
+	}
+	normalized := size
+	if strings.HasSuffix(strings.ToLower(normalized), "ib") {
+		normalized = normalized[:len(normalized)-2]
+	}
+	if bytes, err := units.RAMInBytes(normalized); err != nil || bytes <= 0 {
+		return apierrors.NewBadRequestError(fmt.Errorf("invalid volume size %q", size))
+	}
+	return nil
+}
+
+// mergeAndVerifyConfig merges the image config into config and checks that
+// the result has something to run. The returned warnings describe changes
+// made to config that the user did not ask for explicitly.
+func (daemon *Daemon) mergeAndVerifyConfig(config *containertypes.Config, img *image.Image) ([]string, error) {
+	var warnings []string
 	if img != nil && img.Config != nil {
 		if err := merge(config, img.Config); err != nil {
-			return err
+			return warnings, err
 		}
 	}
 	// Reset the Entrypoint if it is [""]
 	if len(config.Entrypoint) == 1 && config.Entrypoint[0] == "" {
 		config.Entrypoint = nil
+		warnings = append(warnings, `Entrypoint reset from [""]; the command is run without an entrypoint.`)
 	}
 	if len(config.Entrypoint) == 0 && len(config.Cmd) == 0 {
-		return fmt.Errorf("No command specified")
+		return warnings, fmt.Errorf("No command specified")
+	}
+	return warnings, nil
+}
+
+// scratchSizeStorageOpt is the StorageOpt key requesting a tmpfs scratch
+// mount of the given size on the container's working dir. It is handled by
+// the daemon rather than by the storage driver.
+const scratchSizeStorageOpt = "scratch-size"
+
+// parseScratchSize returns the size in bytes of a scratch-size StorageOpt.
+func parseScratchSize(size string) (int64, error) {
+	bytes, err := units.RAMInBytes(size)
+	if err != nil || bytes <= 0 {
+		return 0, apierrors.NewBadRequestError(fmt.Errorf("invalid %s storage-opt %q", scratchSizeStorageOpt, size))
+	}
+	return bytes, nil
+}
+
+// setScratchMount turns a scratch-size StorageOpt in hostConfig into a tmpfs
+// mount of that size on the working dir of config, and removes it from the
+// StorageOpt passed to the storage driver.
+func setScratchMount(config *containertypes.Config, hostConfig *containertypes.HostConfig) error {
+	size, ok := hostConfig.StorageOpt[scratchSizeStorageOpt]
+	if !ok {
+		return nil
+	}
+	bytes, err := parseScratchSize(size)
+	if err != nil {
+		return err
+	}
+	if config.WorkingDir == "" {
+		return apierrors.NewBadRequestError(fmt.Errorf("%s storage-opt requires a working directory", scratchSizeStorageOpt))
+	}
+	if _, exists := hostConfig.Tmpfs[config.WorkingDir]; exists {
+		return apierrors.NewBadRequestError(fmt.Errorf("%s storage-opt conflicts with the tmpfs mount on %s", scratchSizeStorageOpt, config.WorkingDir))
+	}
+	if hostConfig.Tmpfs == nil {
+		hostConfig.Tmpfs = make(map[string]string)
+	}
+	hostConfig.Tmpfs[config.WorkingDir] = "size=" + strconv.FormatInt(bytes, 10)
+
+	storageOpt := make(map[string]string, len(hostConfig.StorageOpt)-1)
+	for k, v := range hostConfig.StorageOpt {
+		if k != scratchSizeStorageOpt {
+			storageOpt[k] = v
+		}
+	}
+	hostConfig.StorageOpt = storageOpt
+	return nil
+}
+
+// verifyStorageOpt asks the storage driver to validate storageOpt, so that
+// an option it does not accept fails the create request up front rather
+// than at layer creation. The scratch-size key is checked on its own as it
+// is handled by the daemon rather than by the driver.
+func (daemon *Daemon) verifyStorageOpt(storageOpt map[string]string) error {
+	if len(storageOpt) == 0 {
+		return nil
+	}
+	driverOpt := storageOpt
+	if size, ok := storageOpt[scratchSizeStorageOpt]; ok {
+		if !scratchMountSupported {
+			return apierrors.NewBadRequestError(fmt.Errorf("%s storage-opt is not supported on %s", scratchSizeStorageOpt, runtime.GOOS))
+		}
+		if _, err := parseScratchSize(size); err != nil {
+			return err
+		}
+		driverOpt = make(map[string]string, len(storageOpt)-1)
+		for k, v := range storageOpt {
+			if k != scratchSizeStorageOpt {
+				driverOpt[k] = v
+			}
+		}
+	}
+	if err := daemon.layerStore.ValidateStorageOpt(driverOpt); err != nil {
+		return apierrors.NewBadRequestError(fmt.Errorf("invalid storage-opt for the %s storage driver: %v", daemon.GraphDriverName(), err))
 	}
 	return nil
 }
@@ -0,0 +1,459 @@
+package daemon
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	containertypes "github.com/docker/docker/api/types/container"
+	eventtypes "github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/container"
+	"github.com/docker/docker/daemon/events"
+	"github.com/docker/docker/image"
+	"github.com/docker/docker/layer"
+	"github.com/docker/docker/pkg/registrar"
+	"github.com/docker/go-metrics"
+)
+
+type fakeRWLayer struct {
+	layer.RWLayer
+	name string
+}
+
+func (l *fakeRWLayer) Name() string {
+	return l.name
+}
+
+// fakeLayerStore implements the parts of layer.Store used by setRWLayer.
+type fakeLayerStore struct {
+	layer.Store
+	driver   string
+	rwLayers map[string]layer.RWLayer
+	created  []string
+	opts     *layer.CreateRWLayerOpts
+	// storageOpts lists the StorageOpt keys the fake driver accepts.
+	storageOpts []string
+	validated   map[string]string
+}
+
+func (s *fakeLayerStore) CreateRWLayer(id string, parent layer.ChainID, opts *layer.CreateRWLayerOpts) (layer.RWLayer, error) {
+	s.created = append(s.created, id)
+	s.opts = opts
+	l := &fakeRWLayer{name: id}
+	s.rwLayers[id] = l
+	return l, nil
+}
+
+func (s *fakeLayerStore) GetRWLayer(id string) (layer.RWLayer, error) {
+	l, ok := s.rwLayers[id]
+	if !ok {
+		return nil, layer.ErrMountDoesNotExist
+	}
+	return l, nil
+}
+
+func (s *fakeLayerStore) DriverName() string {
+	return s.driver
+}
+
+func (s *fakeLayerStore) ValidateStorageOpt(storageOpt map[string]string) error {
+	s.validated = storageOpt
+	for k := range storageOpt {
+		accepted := false
+		for _, opt := range s.storageOpts {
+			accepted = accepted || k == opt
+		}
+		if !accepted {
+			return fmt.Errorf("Unknown option %s", k)
+		}
+	}
+	return nil
+}
+
+func newCreateTestContainer(id string) *container.Container {
+	return &container.Container{
+		CommonContainer: container.CommonContainer{
+			ID:         id,
+			HostConfig: &containertypes.HostConfig{},
+		},
+	}
+}
+
+func TestSetRWLayerReuse(t *testing.T) {
+	existing := &fakeRWLayer{name: "cache"}
+	ls := &fakeLayerStore{rwLayers: map[string]layer.RWLayer{"cache": existing}}
+	daemon := &Daemon{layerStore: ls}
+
+	c := newCreateTestContainer("c1")
+	if err := daemon.setRWLayer(c, "cache", nil); err != nil {
+		t.Fatal(err)
+	}
+	if c.RWLayer != existing {
+		t.Fatalf("expected the existing RW layer to be reused, got %v", c.RWLayer)
+	}
+	if len(ls.created) != 0 {
+		t.Fatalf("expected no RW layer to be created, got %v", ls.created)
+	}
+}
+
+func TestSetRWLayerReuseFallback(t *testing.T) {
+	ls := &fakeLayerStore{rwLayers: map[string]layer.RWLayer{}}
+	daemon := &Daemon{layerStore: ls}
+
+	c := newCreateTestContainer("c1")
+	if err := daemon.setRWLayer(c, "missing", nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(ls.created) != 1 || ls.created[0] != "c1" {
+		t.Fatalf("expected a new RW layer for c1, got %v", ls.created)
+	}
+	if c.RWLayer.Name() != "c1" {
+		t.Fatalf("expected RW layer c1, got %s", c.RWLayer.Name())
+	}
+}
+
+func TestSetRWLayerInitFunc(t *testing.T) {
+	ls := &fakeLayerStore{rwLayers: map[string]layer.RWLayer{}}
+	daemon := &Daemon{layerStore: ls}
+
+	var initRoot string
+	initFunc := func(root string) error {
+		initRoot = root
+		return nil
+	}
+	if err := daemon.setRWLayer(newCreateTestContainer("c1"), "", initFunc); err != nil {
+		t.Fatal(err)
+	}
+	if ls.opts == nil || ls.opts.InitFunc == nil {
+		t.Fatal("expected an init func to be passed to the layer store")
+	}
+	if err := ls.opts.InitFunc("/rootfs"); err != nil {
+		t.Fatal(err)
+	}
+	if initRoot != "/rootfs" {
+		t.Fatalf("expected the override init func to be invoked, got root %q", initRoot)
+	}
+}
+
+func TestVerifyStorageOpt(t *testing.T) {
+	daemon := &Daemon{layerStore: &fakeLayerStore{driver: "overlay2", storageOpts: []string{"size"}}}
+
+	if err := daemon.verifyStorageOpt(map[string]string{"size": "10G"}); err != nil {
+		t.Fatalf("expected size to be accepted, got %v", err)
+	}
+
+	err := daemon.verifyStorageOpt(map[string]string{"size": "10G", "bogus": "1"})
+	if err == nil {
+		t.Fatal("expected an error for an unknown storage-opt key")
+	}
+	if !strings.Contains(err.Error(), "overlay2 storage driver: Unknown option bogus") {
+		t.Fatalf("expected the driver error to be reported, got %v", err)
+	}
+	apiErr, ok := err.(interface {
+		HTTPErrorStatusCode() int
+	})
+	if !ok {
+		t.Fatalf("expected an API error, got %T", err)
+	}
+	if status := apiErr.HTTPErrorStatusCode(); status != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, status)
+	}
+}
+
+func TestVerifyStorageOptScratchSize(t *testing.T) {
+	ls := &fakeLayerStore{driver: "vfs"}
+	daemon := &Daemon{layerStore: ls}
+	if err := daemon.verifyStorageOpt(map[string]string{"scratch-size": "64m"}); err != nil {
+		t.Fatalf("expected scratch-size to be accepted, got %v", err)
+	}
+	if len(ls.validated) != 0 {
+		t.Fatalf("expected scratch-size not to be passed to the driver, got %v", ls.validated)
+	}
+
+	for _, size := range []string{"lots", "0", "-1m"} {
+		err := daemon.verifyStorageOpt(map[string]string{"scratch-size": size})
+		if err == nil || !strings.Contains(err.Error(), "invalid scratch-size") {
+			t.Fatalf("expected %q to be rejected, got %v", size, err)
+		}
+	}
+}
+
+func TestSetScratchMount(t *testing.T) {
+	config := &containertypes.Config{WorkingDir: "/build"}
+	hostConfig := &containertypes.HostConfig{
+		StorageOpt: map[string]string{"scratch-size": "64m", "size": "10G"},
+	}
+	if err := setScratchMount(config, hostConfig); err != nil {
+		t.Fatal(err)
+	}
+	if opts := hostConfig.Tmpfs["/build"]; opts != "size=67108864" {
+		t.Fatalf("expected a 64m tmpfs on /build, got %q", opts)
+	}
+	if _, ok := hostConfig.StorageOpt["scratch-size"]; ok || hostConfig.StorageOpt["size"] != "10G" {
+		t.Fatalf("expected only scratch-size to be removed from the storage opts, got %v", hostConfig.StorageOpt)
+	}
+
+	// The working dir is required.
+	hostConfig = &containertypes.HostConfig{StorageOpt: map[string]string{"scratch-size": "64m"}}
+	if err := setScratchMount(&containertypes.Config{}, hostConfig); err == nil {
+		t.Fatal("expected an error without a working dir")
+	}
+
+	// Without scratch-size nothing changes.
+	hostConfig = &containertypes.HostConfig{StorageOpt: map[string]string{"size": "10G"}}
+	if err := setScratchMount(config, hostConfig); err != nil {
+		t.Fatal(err)
+	}
+	if hostConfig.Tmpfs != nil || len(hostConfig.StorageOpt) != 1 {
+		t.Fatalf("expected the host config to be unchanged, got %+v", hostConfig)
+	}
+}
+
+func TestSetBuildContainerLabel(t *testing.T) {
+	config := &containertypes.Config{Labels: map[string]string{"foo": "bar"}}
+	setBuildContainerLabel(config)
+	if config.Labels[buildContainerLabel] != "1" {
+		t.Fatalf("expected %s=1, got %v", buildContainerLabel, config.Labels)
+	}
+	if config.Labels["foo"] != "bar" {
+		t.Fatalf("expected existing labels to be kept, got %v", config.Labels)
+	}
+
+	config = &containertypes.Config{}
+	setBuildContainerLabel(config)
+	if config.Labels[buildContainerLabel] != "1" {
+		t.Fatalf("expected %s=1, got %v", buildContainerLabel, config.Labels)
+	}
+}
+
+func TestMergeAndVerifyConfigEntrypointReset(t *testing.T) {
+	daemon := &Daemon{}
+	config := &containertypes.Config{
+		Entrypoint: []string{""},
+		Cmd:        []string{"echo", "hello"},
+	}
+	warnings, err := daemon.mergeAndVerifyConfig(config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Entrypoint != nil {
+		t.Fatalf("expected the entrypoint to be reset, got %v", config.Entrypoint)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "Entrypoint reset") {
+		t.Fatalf("expected an entrypoint reset warning, got %v", warnings)
+	}
+}
+
+func TestMergeAndVerifyConfigImageConfig(t *testing.T) {
+	daemon := &Daemon{}
+	img := &image.Image{
+		V1Image: image.V1Image{
+			Config: &containertypes.Config{
+				Cmd: []string{"/bin/sh"},
+				Env: []string{"PATH=/usr/bin"},
+			},
+		},
+	}
+	config := &containertypes.Config{}
+	warnings, err := daemon.mergeAndVerifyConfig(config, img)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 0 {
+		t.Fatalf("expected no warnings, got %v", warnings)
+	}
+	if len(config.Cmd) != 1 || config.Cmd[0] != "/bin/sh" {
+		t.Fatalf("expected the image command to be merged, got %v", config.Cmd)
+	}
+
+	if _, err := daemon.mergeAndVerifyConfig(&containertypes.Config{}, nil); err == nil {
+		t.Fatal("expected an error when no command is specified")
+	}
+}
+
+func TestVerifyImagePlatform(t *testing.T) {
+	daemon := &Daemon{}
+	for _, os := range []string{"", runtime.GOOS} {
+		img := &image.Image{V1Image: image.V1Image{OS: os}}
+		if err := daemon.verifyImagePlatform(img); err != nil {
+			t.Fatalf("expected image with OS %q to be accepted, got %v", os, err)
+		}
+	}
+
+	other := "windows"
+	if runtime.GOOS == "windows" {
+		other = "linux"
+	}
+	img := &image.Image{V1Image: image.V1Image{OS: other}}
+	if err := daemon.verifyImagePlatform(img); err == nil {
+		t.Fatalf("expected image with OS %q to be rejected on %s", other, runtime.GOOS)
+	}
+}
+
+func TestValidateVolumeSizeOpt(t *testing.T) {
+	for _, size := range []string{"512m", "1gib", "10g"} {
+		opts := map[string]string{"size": size, "type": "tmpfs"}
+		if err := validateVolumeSizeOpt("quota", opts); err != nil {
+			t.Fatalf("expected size %q to be valid, got %v", size, err)
+		}
+		if opts["size"] != size {
+			t.Fatalf("expected size %q to be passed to the driver as is, got %s", size, opts["size"])
+		}
+	}
+
+	for _, size := range []string{"garbage", "", "-1g", "10q"} {
+		if err := validateVolumeSizeOpt("quota", map[string]string{"size": size}); err == nil {
+			t.Fatalf("expected size %q to be rejected", size)
+		}
+	}
+
+	for _, driver := range []string{"", "local"} {
+		if err := validateVolumeSizeOpt(driver, map[string]string{"size": "512m"}); err == nil {
+			t.Fatalf("expected the size option to be rejected for driver %q", driver)
+		}
+	}
+
+	if err := validateVolumeSizeOpt("local", map[string]string{"o": "bind"}); err != nil {
+		t.Fatalf("expected options without size to be accepted, got %v", err)
+	}
+}
+
+func TestLogContainerCreateEventManaged(t *testing.T) {
+	e := events.New()
+	_, l, _ := e.Subscribe()
+	defer e.Evict(l)
+
+	c := newCreateTestContainer("container_id")
+	c.Config = &containertypes.Config{}
+	daemon := &Daemon{EventsService: e}
+
+	daemon.logContainerCreateEvent(c, true)
+	validateTestAttributes(t, l, map[string]string{"managed": "true"})
+
+	daemon.logContainerCreateEvent(c, false)
+	select {
+	case ev := <-l:
+		if _, ok := ev.(eventtypes.Message).Actor.Attributes["managed"]; ok {
+			t.Fatalf("expected no managed attribute for an unmanaged container, got %v", ev)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("LogContainerEvent test timed out")
+	}
+}
+
+func TestReserveNameConcurrent(t *testing.T) {
+	daemon := &Daemon{nameIndex: registrar.NewRegistrar()}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 2)
+	for _, id := range []string{"id1", "id2"} {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			_, err := daemon.reserveName(id, "builder")
+			errs <- err
+		}(id)
+	}
+	wg.Wait()
+	close(errs)
+
+	var failures int
+	for err := range errs {
+		if err == nil {
+			continue
+		}
+		failures++
+		if !strings.Contains(err.Error(), "is already in use") {
+			t.Fatalf("expected a name conflict error, got %v", err)
+		}
+	}
+	if failures != 1 {
+		t.Fatalf("expected exactly one create to fail, got %d failures", failures)
+	}
+}
+
+func TestContainerCreateDryRun(t *testing.T) {
+	ls := &fakeLayerStore{driver: "overlay2", rwLayers: map[string]layer.RWLayer{}}
+	daemon := &Daemon{
+		layerStore:       ls,
+		nameIndex:        registrar.NewRegistrar(),
+		defaultLogConfig: containertypes.LogConfig{Type: "json-file"},
+	}
+
+	params := types.ContainerCreateConfig{
+		Name: "lint",
+		Config: &containertypes.Config{
+			Entrypoint: []string{""},
+			Cmd:        []string{"echo", "hello"},
+		},
+		DryRun: true,
+	}
+	created, err := daemon.CreateBuildContainer(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == "" {
+		t.Fatal("expected a synthetic container ID")
+	}
+	if len(created.Warnings) != 1 || !strings.Contains(created.Warnings[0], "Entrypoint reset") {
+		t.Fatalf("expected the entrypoint reset warning, got %v", created.Warnings)
+	}
+	if len(ls.created) != 0 {
+		t.Fatalf("expected no RW layer to be created, got %v", ls.created)
+	}
+	if _, err := daemon.nameIndex.Get("/lint"); err == nil {
+		t.Fatal("expected the name not to be reserved")
+	}
+}
+
+type fakeTimer struct {
+	labels  []string
+	updated *[][]string
+}
+
+func (t fakeTimer) Update(time.Duration) {
+	*t.updated = append(*t.updated, t.labels)
+}
+
+func (t fakeTimer) UpdateSince(time.Time) {
+	*t.updated = append(*t.updated, t.labels)
+}
+
+type fakeLabeledTimer struct {
+	updated [][]string
+}
+
+func (lt *fakeLabeledTimer) WithValues(labels ...string) metrics.Timer {
+	return fakeTimer{labels: labels, updated: &lt.updated}
+}
+
+func TestRecordContainerCreate(t *testing.T) {
+	defer func(actions, createActions metrics.LabeledTimer) {
+		containerActions, containerCreateActions = actions, createActions
+	}(containerActions, containerCreateActions)
+
+	for _, c := range []struct {
+		managed, build bool
+		kind           string
+	}{
+		{false, true, "build"},
+		{true, false, "managed"},
+		{false, false, "regular"},
+	} {
+		actions, createActions := &fakeLabeledTimer{}, &fakeLabeledTimer{}
+		containerActions, containerCreateActions = actions, createActions
+
+		recordContainerCreate(time.Now(), c.managed, c.build)
+		if len(createActions.updated) != 1 || createActions.updated[0][0] != c.kind {
+			t.Fatalf("expected the %s create metric to be updated, got %v", c.kind, createActions.updated)
+		}
+		if len(actions.updated) != 1 || actions.updated[0][0] != "create" {
+			t.Fatalf("expected the create action metric to be updated, got %v", actions.updated)
+		}
+	}
+}
@@ -15,6 +15,10 @@ import (
 	"github.com/opencontainers/runc/libcontainer/label"
 )
 
+// scratchMountSupported is whether the scratch-size StorageOpt, which is
+// backed by a tmpfs mount, can be used.
+const scratchMountSupported = true
+
 // createContainerPlatformSpecificSettings performs platform specific container create functionality
 func (daemon *Daemon) createContainerPlatformSpecificSettings(container *container.Container, config *containertypes.Config, hostConfig *containertypes.HostConfig) error {
 	if err := daemon.Mount(container); err != nil {
@@ -9,6 +9,10 @@ import (
 	"github.com/docker/docker/volume"
 )
 
+// scratchMountSupported is false as Windows has no tmpfs mounts to back the
+// scratch-size StorageOpt.
+const scratchMountSupported = false
+
 // createContainerPlatformSpecificSettings performs platform specific container create functionality
 func (daemon *Daemon) createContainerPlatformSpecificSettings(container *container.Container, config *containertypes.Config, hostConfig *containertypes.HostConfig) error {
 	// Make sure the host config has the default daemon isolation if not specified by caller.
@@ -125,6 +125,12 @@ func (daemon *Daemon) cleanupContainer(container *container.Container, forceRemo
 		return fmt.Errorf("Unable to remove filesystem for %v: %v", container.ID, err)
 	}
 
+	// The bundle of a build container is kept after it exits until it has
+	// been committed, which is done by now.
+	if e := daemon.containerd.CleanupBuild(container.ID); e != nil {
+		logrus.Warnf("Failed to remove the bundle of build container %s: %v", container.ID, e)
+	}
+
 	// When container creation fails and `RWLayer` has not been created yet, we
 	// do not call `ReleaseRWLayer`
 	if container.RWLayer != nil {
@@ -209,12 +209,22 @@ func (a *Driver) CreateReadWrite(id, parent string, opts *graphdriver.CreateOpts
 	return a.Create(id, parent, opts)
 }
 
+// ValidateStorageOpt rejects any storage option, as aufs does not support them.
+func (a *Driver) ValidateStorageOpt(storageOpt map[string]string) error {
+	if len(storageOpt) != 0 {
+		return fmt.Errorf("--storage-opt is not supported for aufs")
+	}
+	return nil
+}
+
 // Create three folders for each id
 // mnt, layers, and diff
 func (a *Driver) Create(id, parent string, opts *graphdriver.CreateOpts) error {
 
-	if opts != nil && len(opts.StorageOpt) != 0 {
-		return fmt.Errorf("--storage-opt is not supported for aufs")
+	if opts != nil {
+		if err := a.ValidateStorageOpt(opts.StorageOpt); err != nil {
+			return err
+		}
 	}
 
 	if err := a.createDirsFor(id); err != nil {
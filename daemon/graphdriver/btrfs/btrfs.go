@@ -439,6 +439,11 @@ func (d *Driver) Create(id, parent string, opts *graphdriver.CreateOpts) error {
 	return label.Relabel(path.Join(subvolumes, id), mountLabel, false)
 }
 
+// ValidateStorageOpt checks storageOpt the way Create does.
+func (d *Driver) ValidateStorageOpt(storageOpt map[string]string) error {
+	return d.parseStorageOpt(storageOpt, &Driver{})
+}
+
 // Parse btrfs storage options
 func (d *Driver) parseStorageOpt(storageOpt map[string]string, driver *Driver) error {
 	// Read size to change the subvolume disk quota per container
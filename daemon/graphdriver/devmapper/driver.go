@@ -126,6 +126,12 @@ func (d *Driver) CreateReadWrite(id, parent string, opts *graphdriver.CreateOpts
 	return d.Create(id, parent, opts)
 }
 
+// ValidateStorageOpt checks storageOpt the way Create does.
+func (d *Driver) ValidateStorageOpt(storageOpt map[string]string) error {
+	_, err := d.DeviceSet.parseStorageOpt(storageOpt)
+	return err
+}
+
 // Create adds a device with a given id and the parent.
 func (d *Driver) Create(id, parent string, opts *graphdriver.CreateOpts) error {
 	var storageOpt map[string]string
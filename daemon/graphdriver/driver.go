@@ -121,6 +121,14 @@ type DiffGetterDriver interface {
 	DiffGetter(id string) (FileGetCloser, error)
 }
 
+// StorageOptValidator is the interface implemented by drivers that can check
+// the StorageOpt of a CreateOpts without creating a layer.
+type StorageOptValidator interface {
+	// ValidateStorageOpt returns the error creating a read-write layer with
+	// storageOpt would fail with, or nil if the driver accepts it.
+	ValidateStorageOpt(storageOpt map[string]string) error
+}
+
 // FileGetCloser extends the storage.FileGetter interface with a Close method
 // for cleaning up.
 type FileGetCloser interface {
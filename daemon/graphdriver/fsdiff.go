@@ -172,3 +172,12 @@ func (gdw *NaiveDiffDriver) DiffSize(id, parent string) (size int64, err error)
 
 	return archive.ChangesSize(layerFs, changes), nil
 }
+
+// ValidateStorageOpt checks storageOpt with the wrapped driver if it is a
+// StorageOptValidator, and accepts it otherwise.
+func (gdw *NaiveDiffDriver) ValidateStorageOpt(storageOpt map[string]string) error {
+	if v, ok := gdw.ProtoDriver.(StorageOptValidator); ok {
+		return v.ValidateStorageOpt(storageOpt)
+	}
+	return nil
+}
@@ -235,12 +235,22 @@ func (d *Driver) CreateReadWrite(id, parent string, opts *graphdriver.CreateOpts
 	return d.Create(id, parent, opts)
 }
 
+// ValidateStorageOpt rejects any storage option, as overlay does not support them.
+func (d *Driver) ValidateStorageOpt(storageOpt map[string]string) error {
+	if len(storageOpt) != 0 {
+		return fmt.Errorf("--storage-opt is not supported for overlay")
+	}
+	return nil
+}
+
 // Create is used to create the upper, lower, and merge directories required for overlay fs for a given id.
 // The parent filesystem is used to configure these directories for the overlay.
 func (d *Driver) Create(id, parent string, opts *graphdriver.CreateOpts) (retErr error) {
 
-	if opts != nil && len(opts.StorageOpt) != 0 {
-		return fmt.Errorf("--storage-opt is not supported for overlay")
+	if opts != nil {
+		if err := d.ValidateStorageOpt(opts.StorageOpt); err != nil {
+			return err
+		}
 	}
 
 	dir := d.dir(id)
@@ -382,6 +382,17 @@ func (d *Driver) Create(id, parent string, opts *graphdriver.CreateOpts) (retErr
 	return nil
 }
 
+// ValidateStorageOpt checks storageOpt the way Create does.
+func (d *Driver) ValidateStorageOpt(storageOpt map[string]string) error {
+	if len(storageOpt) == 0 {
+		return nil
+	}
+	if !projectQuotaSupported {
+		return fmt.Errorf("--storage-opt is supported only for overlay over xfs with 'pquota' mount option")
+	}
+	return d.parseStorageOpt(storageOpt, &Driver{})
+}
+
 // Parse overlay storage options
 func (d *Driver) parseStorageOpt(storageOpt map[string]string, driver *Driver) error {
 	// Read size to set the disk project quota per container
@@ -74,10 +74,20 @@ func (d *Driver) CreateReadWrite(id, parent string, opts *graphdriver.CreateOpts
 	return d.Create(id, parent, opts)
 }
 
+// ValidateStorageOpt rejects any storage option, as vfs does not support them.
+func (d *Driver) ValidateStorageOpt(storageOpt map[string]string) error {
+	if len(storageOpt) != 0 {
+		return fmt.Errorf("--storage-opt is not supported for vfs")
+	}
+	return nil
+}
+
 // Create prepares the filesystem for the VFS driver and copies the directory for the given id under the parent.
 func (d *Driver) Create(id, parent string, opts *graphdriver.CreateOpts) error {
-	if opts != nil && len(opts.StorageOpt) != 0 {
-		return fmt.Errorf("--storage-opt is not supported for vfs")
+	if opts != nil {
+		if err := d.ValidateStorageOpt(opts.StorageOpt); err != nil {
+			return err
+		}
 	}
 
 	dir := d.dir(id)
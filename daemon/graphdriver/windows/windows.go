@@ -861,6 +861,14 @@ func (d *Driver) DiffGetter(id string) (graphdriver.FileGetCloser, error) {
 	return &fileGetCloserWithBackupPrivileges{d.dir(id)}, nil
 }
 
+// ValidateStorageOpt checks storageOpt the way CreateReadWrite does.
+func (d *Driver) ValidateStorageOpt(storageOpt map[string]string) error {
+	if _, err := parseStorageOpt(storageOpt); err != nil {
+		return fmt.Errorf("Failed to parse storage options - %s", err)
+	}
+	return nil
+}
+
 type storageOptions struct {
 	size uint64
 }
@@ -314,6 +314,12 @@ func (d *Driver) create(id, parent string, storageOpt map[string]string) error {
 	return err
 }
 
+// ValidateStorageOpt checks storageOpt the way Create does.
+func (d *Driver) ValidateStorageOpt(storageOpt map[string]string) error {
+	_, err := parseStorageOpt(storageOpt)
+	return err
+}
+
 func parseStorageOpt(storageOpt map[string]string) (string, error) {
 	// Read size to change the disk quota per container
 	for k, v := range storageOpt {
@@ -4,6 +4,7 @@ import "github.com/docker/go-metrics"
 
 var (
 	containerActions          metrics.LabeledTimer
+	containerCreateActions    metrics.LabeledTimer
 	imageActions              metrics.LabeledTimer
 	networkActions            metrics.LabeledTimer
 	engineVersion             metrics.LabeledGauge
@@ -25,6 +26,14 @@ func init() {
 	} {
 		containerActions.WithValues(a).Update(0)
 	}
+	containerCreateActions = ns.NewLabeledTimer("container_create_actions", "The number of seconds it takes to create each kind of container", "kind")
+	for _, k := range []string{
+		"managed",
+		"build",
+		"regular",
+	} {
+		containerCreateActions.WithValues(k).Update(0)
+	}
 	networkActions = ns.NewLabeledTimer("network_actions", "The number of seconds it takes to process each network action", "action")
 	engineVersion = ns.NewLabeledGauge("engine", "The version and commit information for the engine process", metrics.Unit("info"),
 		"version",
@@ -161,10 +161,12 @@ func (daemon *Daemon) GetFirstContainerBuildingStatus(id string) bool {
 }
 
 
-func (daemon *Daemon) TriggerExitEvent(cId string) error {
+// TriggerExitEvent reports the exit of the init process of the build container
+// cId with the exit status of the build step that ran in it.
+func (daemon *Daemon) TriggerExitEvent(cId string, exitCode uint32) error {
      fmt.Println("daemon/monitor.go TriggerExitEvent()")
 
-     if err :=  daemon.containerd.TriggerHandleStream(cId); err != nil {
+     if err :=  daemon.containerd.TriggerHandleStream(cId, libcontainerd.InitFriendlyName, exitCode); err != nil {
         fmt.Println("daemon/monitor.go TriggerExitEvent() error!!!")
         return err
      }
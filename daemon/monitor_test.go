@@ -0,0 +1,31 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/docker/docker/libcontainerd"
+)
+
+type fakeContainerdClient struct {
+	libcontainerd.Client
+	exitPid  string
+	exitCode uint32
+}
+
+func (c *fakeContainerdClient) TriggerHandleStream(cId string, pid string, exitCode uint32) error {
+	c.exitPid = pid
+	c.exitCode = exitCode
+	return nil
+}
+
+func TestTriggerExitEventExitCode(t *testing.T) {
+	client := &fakeContainerdClient{}
+	daemon := &Daemon{containerd: client}
+
+	if err := daemon.TriggerExitEvent("build", 2); err != nil {
+		t.Fatal(err)
+	}
+	if client.exitPid != libcontainerd.InitFriendlyName || client.exitCode != 2 {
+		t.Fatalf("expected the init exit with the step exit code 2, got %s with %d", client.exitPid, client.exitCode)
+	}
+}
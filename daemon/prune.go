@@ -8,6 +8,7 @@ import (
 	"github.com/docker/distribution/digest"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/container"
 	"github.com/docker/docker/image"
 	"github.com/docker/docker/layer"
 	"github.com/docker/docker/pkg/directory"
@@ -23,7 +24,7 @@ func (daemon *Daemon) ContainersPrune(pruneFilters filters.Args) (*types.Contain
 
 	allContainers := daemon.List()
 	for _, c := range allContainers {
-		if !c.IsRunning() {
+		if !c.IsRunning() && !daemon.shouldExcludeFromPrune(c) {
 			cSize, _ := daemon.getSize(c)
 			// TODO: sets RmLink to true?
 			err := daemon.ContainerRm(c.ID, &types.ContainerRmConfig{})
@@ -41,6 +42,48 @@ func (daemon *Daemon) ContainersPrune(pruneFilters filters.Args) (*types.Contain
 	return rep, nil
 }
 
+// shouldExcludeFromPrune returns true if container prune must keep c. Build
+// step containers are kept until their step has finished, including while
+// they are created but not started yet.
+func (daemon *Daemon) shouldExcludeFromPrune(c *container.Container) bool {
+	if c.Config == nil {
+		return false
+	}
+	if _, ok := c.Config.Labels[buildContainerLabel]; !ok {
+		return false
+	}
+	return c.IsRunning() || c.FinishedAt.IsZero()
+}
+
+// BuildCachePrune removes stopped containers that were created for build
+// steps. Only the "label" filter is supported.
+func (daemon *Daemon) BuildCachePrune(pruneFilters filters.Args) (*types.BuildCachePruneReport, error) {
+	rep := &types.BuildCachePruneReport{}
+
+	for _, c := range daemon.List() {
+		if c.Config == nil || daemon.shouldExcludeFromPrune(c) {
+			continue
+		}
+		if _, ok := c.Config.Labels[buildContainerLabel]; !ok {
+			continue
+		}
+		if !pruneFilters.MatchKVList("label", c.Config.Labels) {
+			continue
+		}
+		cSize, _ := daemon.getSize(c)
+		if err := daemon.ContainerRm(c.ID, &types.ContainerRmConfig{}); err != nil {
+			logrus.Warnf("failed to prune build container %s: %v", c.ID, err)
+			continue
+		}
+		if cSize > 0 {
+			rep.SpaceReclaimed += uint64(cSize)
+		}
+		rep.CachesDeleted = append(rep.CachesDeleted, c.ID)
+	}
+
+	return rep, nil
+}
+
 // VolumesPrune removes unused local volumes
 func (daemon *Daemon) VolumesPrune(pruneFilters filters.Args) (*types.VolumesPruneReport, error) {
 	rep := &types.VolumesPruneReport{}
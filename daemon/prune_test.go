@@ -0,0 +1,61 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	containertypes "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/container"
+)
+
+func newPruneTestContainer(labels map[string]string) *container.Container {
+	c := newCreateTestContainer("c1")
+	c.Config = &containertypes.Config{Labels: labels}
+	c.State = container.NewState()
+	return c
+}
+
+func TestShouldExcludeFromPrune(t *testing.T) {
+	daemon := &Daemon{}
+	buildLabels := map[string]string{buildContainerLabel: "1"}
+
+	running := newPruneTestContainer(buildLabels)
+	running.SetRunning(1234, true)
+	if !daemon.shouldExcludeFromPrune(running) {
+		t.Fatal("expected a running build container to be excluded")
+	}
+
+	created := newPruneTestContainer(buildLabels)
+	if !daemon.shouldExcludeFromPrune(created) {
+		t.Fatal("expected a build container not started yet to be excluded")
+	}
+
+	finished := newPruneTestContainer(buildLabels)
+	finished.FinishedAt = time.Now()
+	if daemon.shouldExcludeFromPrune(finished) {
+		t.Fatal("expected a finished build container not to be excluded")
+	}
+
+	regular := newPruneTestContainer(map[string]string{"foo": "bar"})
+	if daemon.shouldExcludeFromPrune(regular) {
+		t.Fatal("expected a regular container not to be excluded")
+	}
+}
+
+func TestBuildCachePruneKeepsCreatedBuildContainer(t *testing.T) {
+	daemon := &Daemon{containers: container.NewMemoryStore()}
+	created := newPruneTestContainer(map[string]string{buildContainerLabel: "1"})
+	daemon.containers.Add(created.ID, created)
+
+	rep, err := daemon.BuildCachePrune(filters.NewArgs())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rep.CachesDeleted) != 0 {
+		t.Fatalf("expected the build container not started yet to be kept, got %v", rep.CachesDeleted)
+	}
+	if daemon.containers.Get(created.ID) == nil {
+		t.Fatal("expected the build container to still exist")
+	}
+}
@@ -26,6 +26,9 @@ func (daemon *Daemon) getLibcontainerdCreateOptions(container *container.Contain
 		rt.Args = append(rt.Args, "--systemd-cgroup=true")
 	}
 	createOptions = append(createOptions, libcontainerd.WithRuntime(rt.Path, rt.Args))
+	if container.GetBuildingStatus() {
+		createOptions = append(createOptions, libcontainerd.WithBuilding())
+	}
 
 	return createOptions, nil
 }
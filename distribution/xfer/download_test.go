@@ -153,6 +153,10 @@ func (ls *mockLayerStore) DriverName() string {
 	return "mock"
 }
 
+func (ls *mockLayerStore) ValidateStorageOpt(map[string]string) error {
+	return nil
+}
+
 type mockDownloadDescriptor struct {
 	currentDownloads *int32
 	id               string
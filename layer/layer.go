@@ -192,6 +192,7 @@ type Store interface {
 	Cleanup() error
 	DriverStatus() [][2]string
 	DriverName() string
+	ValidateStorageOpt(storageOpt map[string]string) error
 }
 
 // DescribableStore represents a layer store capable of storing
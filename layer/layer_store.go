@@ -685,6 +685,16 @@ func (ls *layerStore) DriverName() string {
 	return ls.driver.String()
 }
 
+// ValidateStorageOpt returns the error the graph driver would fail to create
+// a read-write layer with storageOpt with. Drivers which cannot check it up
+// front report it from CreateRWLayer instead.
+func (ls *layerStore) ValidateStorageOpt(storageOpt map[string]string) error {
+	if v, ok := ls.driver.(graphdriver.StorageOptValidator); ok {
+		return v.ValidateStorageOpt(storageOpt)
+	}
+	return nil
+}
+
 type naiveDiffPathDriver struct {
 	graphdriver.Driver
 }
@@ -304,6 +304,19 @@ func TestMountAndRegister(t *testing.T) {
 	}
 }
 
+func TestValidateStorageOpt(t *testing.T) {
+	ls, _, cleanup := newTestStore(t)
+	defer cleanup()
+
+	if err := ls.ValidateStorageOpt(nil); err != nil {
+		t.Fatal(err)
+	}
+	err := ls.ValidateStorageOpt(map[string]string{"size": "10G"})
+	if err == nil || err.Error() != "--storage-opt is not supported for vfs" {
+		t.Fatalf("expected the vfs driver to reject storage options, got %v", err)
+	}
+}
+
 func TestLayerRelease(t *testing.T) {
 	// TODO Windows: Figure out why this is failing
 	if runtime.GOOS == "windows" {
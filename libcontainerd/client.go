@@ -2,6 +2,7 @@ package libcontainerd
 
 import (
 	"fmt"
+	"os"
 	"sync"
 
 	"github.com/docker/docker/pkg/locker"
@@ -11,8 +12,13 @@ import (
 type clientCommon struct {
 	backend    Backend
 	containers map[string]*container
-	locker     *locker.Locker
-	mapMutex   sync.RWMutex // protects read/write oprations from containers map
+	// containerIDs holds the keys of containers in registration order.
+	containerIDs []string
+	locker       *locker.Locker
+	mapMutex     sync.RWMutex // protects read/write oprations from containers map and containerIDs
+	// buildDirs holds the bundle dirs of exited build containers until
+	// CleanupBuild removes them. Protected by mapMutex.
+	buildDirs map[string]string
 }
 
 func (clnt *client) lock(containerID string) {
@@ -26,15 +32,47 @@ func (clnt *client) unlock(containerID string) {
 // must hold a lock for cont.containerID
 func (clnt *client) appendContainer(cont *container) {
 	clnt.mapMutex.Lock()
+	if _, ok := clnt.containers[cont.containerID]; !ok {
+		clnt.containerIDs = append(clnt.containerIDs, cont.containerID)
+	}
 	clnt.containers[cont.containerID] = cont
 	clnt.mapMutex.Unlock()
 }
 func (clnt *client) deleteContainer(containerID string) {
 	clnt.mapMutex.Lock()
+	if _, ok := clnt.containers[containerID]; ok {
+		for i, id := range clnt.containerIDs {
+			if id == containerID {
+				clnt.containerIDs = append(clnt.containerIDs[:i], clnt.containerIDs[i+1:]...)
+				break
+			}
+		}
+	}
 	delete(clnt.containers, containerID)
 	clnt.mapMutex.Unlock()
 }
 
+// ListContainers returns the IDs of the registered containers in the order
+// they were registered.
+func (clnt *client) ListContainers() []string {
+	clnt.mapMutex.RLock()
+	defer clnt.mapMutex.RUnlock()
+	ids := make([]string, len(clnt.containerIDs))
+	copy(ids, clnt.containerIDs)
+	return ids
+}
+
+// FirstContainer returns the ID of the earliest registered container that is
+// still registered, if any.
+func (clnt *client) FirstContainer() (string, bool) {
+	clnt.mapMutex.RLock()
+	defer clnt.mapMutex.RUnlock()
+	if len(clnt.containerIDs) == 0 {
+		return "", false
+	}
+	return clnt.containerIDs[0], true
+}
+
 func (clnt *client) getContainer(containerID string) (*container, error) {
 	clnt.mapMutex.RLock()
 	container, ok := clnt.containers[containerID]
@@ -44,3 +82,32 @@ func (clnt *client) getContainer(containerID string) (*container, error) {
 	}
 	return container, nil
 }
+
+// keepBuildDir records dir as the bundle dir of the exited build container
+// containerID, to be removed by CleanupBuild.
+func (clnt *client) keepBuildDir(containerID, dir string) {
+	clnt.mapMutex.Lock()
+	if clnt.buildDirs == nil {
+		clnt.buildDirs = make(map[string]string)
+	}
+	clnt.buildDirs[containerID] = dir
+	clnt.mapMutex.Unlock()
+}
+
+// CleanupBuild removes the bundle dir kept after the exit of the build
+// container containerID, once its layer has been committed. It is a no-op if
+// there is no such dir, or if the container has been created again since and
+// uses the dir.
+func (clnt *client) CleanupBuild(containerID string) error {
+	clnt.lock(containerID)
+	defer clnt.unlock(containerID)
+	clnt.mapMutex.Lock()
+	dir, ok := clnt.buildDirs[containerID]
+	delete(clnt.buildDirs, containerID)
+	_, active := clnt.containers[containerID]
+	clnt.mapMutex.Unlock()
+	if !ok || active {
+		return nil
+	}
+	return os.RemoveAll(dir)
+}
@@ -26,6 +26,10 @@ type client struct {
 	q             queue
 	exitNotifiers map[string]*exitNotifier
 	liveRestore   bool
+	subscribersMu sync.Mutex
+	subscribers   map[chan StateInfo]struct{}
+	metricsMu     sync.Mutex
+	metricsHook   MetricsHook
 }
 
 // GetServerVersion returns the connected server version information
@@ -44,7 +48,8 @@ func (clnt *client) GetServerVersion(ctx context.Context) (*ServerVersion, error
 
 // AddProcess is the handler for adding a process to an already running
 // container. It's called through docker exec. It returns the system pid of the
-// exec'd process.
+// exec'd process. The process is run with the runtime of the container, and
+// with its runtime args unless specp.RuntimeArgs is set.
 func (clnt *client) AddProcess(ctx context.Context, containerID, processFriendlyName string, specp Process, attachStdio StdioCallback) (int, error) {
 	clnt.lock(containerID)
 	defer clnt.unlock(containerID)
@@ -106,6 +111,7 @@ func (clnt *client) AddProcess(ctx context.Context, containerID, processFriendly
 		SelinuxLabel:    sp.SelinuxLabel,
 		NoNewPrivileges: sp.NoNewPrivileges,
 		Rlimits:         convertRlimits(sp.Rlimits),
+		RuntimeArgs:     specp.RuntimeArgs,
 	}
 
 	iopipe, err := p.openFifos(sp.Terminal)
@@ -153,6 +159,8 @@ func (clnt *client) AddProcess(ctx context.Context, containerID, processFriendly
 	return int(resp.SystemPid), nil
 }
 
+// SignalProcess sends sig to the process pid of the container, which is the
+// friendly name of an exec'd process or InitFriendlyName.
 func (clnt *client) SignalProcess(containerID string, pid string, sig int) error {
 	clnt.lock(containerID)
 	defer clnt.unlock(containerID)
@@ -317,12 +325,40 @@ func (clnt *client) getExitNotifier(containerID string) *exitNotifier {
 	return clnt.exitNotifiers[containerID]
 }
 
+// WaitExit waits up to timeout for the container to exit and returns whether
+// it did. A container that is not known to the client and has no registered
+// exit notifier has already exited.
+func (clnt *client) WaitExit(containerID string, timeout time.Duration) (exited bool) {
+	en := clnt.getExitNotifier(containerID)
+	if en == nil {
+		if _, err := clnt.getContainer(containerID); err != nil {
+			return true
+		}
+		en = clnt.getOrCreateExitNotifier(containerID)
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-en.Wait():
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+// RegisterExitNotifier returns the exit notifier of the container, creating
+// it if needed. Registering before the container is started guarantees that
+// its exit is not missed. There is a single notifier per container ID.
+func (clnt *client) RegisterExitNotifier(containerID string) *exitNotifier {
+	return clnt.getOrCreateExitNotifier(containerID)
+}
+
 func (clnt *client) getOrCreateExitNotifier(containerID string) *exitNotifier {
 	clnt.mapMutex.Lock()
 	w, ok := clnt.exitNotifiers[containerID]
 	defer clnt.mapMutex.Unlock()
 	if !ok {
-		w = &exitNotifier{c: make(chan struct{}), client: clnt}
+		w = &exitNotifier{id: containerID, c: make(chan struct{}), client: clnt}
 		clnt.exitNotifiers[containerID] = w
 	}
 	return w
@@ -545,12 +581,12 @@ func (clnt *client) Restore(containerID string, attachStdio StdioCallback, optio
 		}
 		select {
 		case <-time.After(2 * time.Second):
-		case <-w.wait():
+		case <-w.Wait():
 			// relock because of the defer
 			clnt.remote.Lock()
 			return nil
 		}
-	case <-w.wait():
+	case <-w.Wait():
 		// relock because of the defer
 		clnt.remote.Lock()
 		return nil
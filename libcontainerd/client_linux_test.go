@@ -0,0 +1,113 @@
+package libcontainerd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"syscall"
+	"testing"
+	"time"
+
+	containerd "github.com/docker/containerd/api/grpc/types"
+	"golang.org/x/net/context"
+)
+
+func TestSignalProcess(t *testing.T) {
+	api := &fakeAPIClient{}
+	clnt := newTestClient(api, newFakeBackend())
+
+	if err := clnt.SignalProcess("c1", "exec1", int(syscall.SIGTERM)); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.signalReqs) != 1 {
+		t.Fatalf("expected a single signal request, got %d", len(api.signalReqs))
+	}
+	r := api.signalReqs[0]
+	if r.Id != "c1" || r.Pid != "exec1" || r.Signal != uint32(syscall.SIGTERM) {
+		t.Fatalf("expected SIGTERM for process exec1 of c1, got %+v", r)
+	}
+}
+
+func TestWaitExitTimeout(t *testing.T) {
+	clnt := newTestClient(&fakeAPIClient{}, newFakeBackend())
+	ctr := newTestContainer(t, clnt, "c1")
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+	clnt.appendContainer(ctr)
+
+	start := time.Now()
+	if clnt.WaitExit("c1", 100*time.Millisecond) {
+		t.Fatal("expected the wait to time out")
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond || elapsed > 5*time.Second {
+		t.Fatalf("expected the wait to return after the timeout, took %v", elapsed)
+	}
+}
+
+func TestWaitExit(t *testing.T) {
+	clnt := newTestClient(&fakeAPIClient{}, newFakeBackend())
+	ctr := newTestContainer(t, clnt, "c1")
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+	clnt.appendContainer(ctr)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		ctr.handleEvent(&containerd.Event{Type: StateExit, Id: "c1", Pid: InitFriendlyName})
+	}()
+	if !clnt.WaitExit("c1", 10*time.Second) {
+		t.Fatal("expected the container exit to be noticed")
+	}
+	if !clnt.WaitExit("c1", 10*time.Second) {
+		t.Fatal("expected an unknown container to have exited")
+	}
+}
+
+func TestRegisterExitNotifier(t *testing.T) {
+	clnt := newTestClient(&fakeAPIClient{}, newFakeBackend())
+	en := clnt.RegisterExitNotifier("c1")
+	if clnt.RegisterExitNotifier("c1") != en {
+		t.Fatal("expected a single notifier per container")
+	}
+
+	ctr := newTestContainer(t, clnt, "c1")
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+	clnt.appendContainer(ctr)
+	if err := ctr.handleEvent(&containerd.Event{Type: StateExit, Id: "c1", Pid: InitFriendlyName}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-en.Wait():
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the registered notifier to fire on exit")
+	}
+	if clnt.RegisterExitNotifier("c1") == en {
+		t.Fatal("expected the fired notifier to be released")
+	}
+}
+
+func TestAddProcessRuntimeArgs(t *testing.T) {
+	api := &fakeAPIClient{}
+	clnt := newTestClient(api, newFakeBackend())
+	ctr := newTestContainer(t, clnt, "c1")
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+	clnt.appendContainer(ctr)
+
+	runtimeArgs := []string{"--systemd-cgroup"}
+	for i, specp := range []Process{
+		{Args: []string{"true"}},
+		{Args: []string{"true"}, RuntimeArgs: runtimeArgs},
+	} {
+		if _, err := clnt.AddProcess(context.Background(), "c1", fmt.Sprintf("exec%d", i), specp, noopAttach); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(api.addReqs) != 2 {
+		t.Fatalf("expected 2 add-process requests, got %d", len(api.addReqs))
+	}
+	if len(api.addReqs[0].RuntimeArgs) != 0 {
+		t.Fatalf("expected no runtime args to keep the container's, got %v", api.addReqs[0].RuntimeArgs)
+	}
+	if !reflect.DeepEqual(api.addReqs[1].RuntimeArgs, runtimeArgs) {
+		t.Fatalf("expected the runtime args %v to be requested, got %v", runtimeArgs, api.addReqs[1].RuntimeArgs)
+	}
+}
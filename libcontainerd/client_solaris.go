@@ -1,6 +1,10 @@
 package libcontainerd
 
-import "golang.org/x/net/context"
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+)
 
 type client struct {
 	clientCommon
@@ -10,6 +14,10 @@ type client struct {
 	q             queue
 	exitNotifiers map[string]*exitNotifier
 	liveRestore   bool
+	subscribersMu sync.Mutex
+	subscribers   map[chan StateInfo]struct{}
+	metricsMu     sync.Mutex
+	metricsHook   MetricsHook
 }
 
 // GetServerVersion returns the connected server version information
@@ -61,7 +69,7 @@ func (clnt *client) getOrCreateExitNotifier(containerID string) *exitNotifier {
 	defer clnt.mapMutex.Unlock()
 	w, ok := clnt.exitNotifiers[containerID]
 	if !ok {
-		w = &exitNotifier{c: make(chan struct{}), client: clnt}
+		w = &exitNotifier{id: containerID, c: make(chan struct{}), client: clnt}
 		clnt.exitNotifiers[containerID] = w
 	}
 	return w
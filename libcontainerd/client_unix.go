@@ -34,7 +34,7 @@ func (clnt *client) prepareBundleDir(uid, gid int) (string, error) {
 		}
 		if os.IsNotExist(err) || fi.Mode()&1 == 0 {
 			p = fmt.Sprintf("%s.%d.%d", p, uid, gid)
-			if err := idtools.MkdirAs(p, 0700, uid, gid); err != nil && !os.IsExist(err) {
+			if err := mkdirBundleAs(p, uid, gid); err != nil {
 				return "", err
 			}
 		}
@@ -42,6 +42,26 @@ func (clnt *client) prepareBundleDir(uid, gid int) (string, error) {
 	return p, nil
 }
 
+// mkdirBundleAs creates a bundle parent dir owned by uid and gid. A dir that
+// already exists, e.g. because a concurrent Create made it, is accepted.
+func mkdirBundleAs(p string, uid, gid int) error {
+	err := idtools.MkdirAs(p, 0700, uid, gid)
+	if err == nil {
+		return nil
+	}
+	if !os.IsExist(err) {
+		return err
+	}
+	fi, statErr := os.Stat(p)
+	if statErr != nil {
+		return statErr
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("bundle dir %s exists and is not a directory", p)
+	}
+	return nil
+}
+
 func (clnt *client) Create(containerID string, checkpoint string, checkpointDir string, spec specs.Spec, attachStdio StdioCallback, options ...CreateOption) (err error) {
 	clnt.lock(containerID)
 	defer clnt.unlock(containerID)
@@ -98,7 +118,9 @@ func (clnt *client) Create(containerID string, checkpoint string, checkpointDir
 
 
 
-func (clnt *client) TriggerHandleStream(cId string) error {
+// TriggerHandleStream feeds a synthetic exit event for process pid of the
+// container to the event handler, as if containerd had reported it.
+func (clnt *client) TriggerHandleStream(cId string, pid string, exitCode uint32) error {
     fmt.Println("libcontainerd/client_unix.go TriggerHandleStream()") 
 
     container, err := clnt.getContainer(cId)
@@ -112,11 +134,10 @@ func (clnt *client) TriggerHandleStream(cId string) error {
 	}
 
     e := &containerd.Event{
-         Type:      "exit",
+         Type:      StateExit,
          Id:        cId,
-         //         Status:    "",
-         Pid:       "init",
-         //         Timestamp  time.Now().UnixNano()
+         Status:    exitCode,
+         Pid:       pid,
     }
 
     if err := container.handleEvent(e); err != nil {
@@ -131,6 +152,64 @@ func (clnt *client) TriggerHandleStream(cId string) error {
 
 
 
+// subscriberBuffer is how many state changes a subscriber can lag behind
+// before further ones are dropped for it.
+const subscriberBuffer = 64
+
+// Subscribe returns a channel receiving the state changes handled for the
+// client's containers, and a function ending the subscription. State changes
+// are dropped for a subscriber whose channel is full.
+func (clnt *client) Subscribe() (<-chan StateInfo, func()) {
+	ch := make(chan StateInfo, subscriberBuffer)
+	clnt.subscribersMu.Lock()
+	if clnt.subscribers == nil {
+		clnt.subscribers = make(map[chan StateInfo]struct{})
+	}
+	clnt.subscribers[ch] = struct{}{}
+	clnt.subscribersMu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			clnt.subscribersMu.Lock()
+			delete(clnt.subscribers, ch)
+			clnt.subscribersMu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+// publish sends st to all subscribers without blocking.
+func (clnt *client) publish(st StateInfo) {
+	clnt.subscribersMu.Lock()
+	defer clnt.subscribersMu.Unlock()
+	for ch := range clnt.subscribers {
+		select {
+		case ch <- st:
+		default:
+			logrus.Debugf("libcontainerd: dropping state change %s for a slow subscriber", st.State)
+		}
+	}
+}
+
+// SetMetricsHook sets the hook called for each exit, pause, resume and OOM
+// event handled by the client. A nil hook disables it, which is the default.
+func (clnt *client) SetMetricsHook(hook MetricsHook) {
+	clnt.metricsMu.Lock()
+	clnt.metricsHook = hook
+	clnt.metricsMu.Unlock()
+}
+
+// reportMetrics calls the metrics hook, if any, for st of containerID.
+func (clnt *client) reportMetrics(containerID string, st StateInfo) {
+	clnt.metricsMu.Lock()
+	hook := clnt.metricsHook
+	clnt.metricsMu.Unlock()
+	if hook != nil {
+		hook(containerID, st.State, st.ExitCode)
+	}
+}
+
 func (clnt *client) Signal(containerID string, sig int) error {
 	clnt.lock(containerID)
 	defer clnt.unlock(containerID)
@@ -155,6 +234,7 @@ func (clnt *client) newContainer(dir string, options ...CreateOption) *container
 			},
 			processes: make(map[string]*process),
 		},
+		started: make(chan struct{}),
 	}
 	for _, option := range options {
 		if err := option.Apply(container); err != nil {
@@ -183,6 +263,8 @@ func (en *exitNotifier) close() {
 		en.client.mapMutex.Unlock()
 	})
 }
-func (en *exitNotifier) wait() <-chan struct{} {
+
+// Wait returns a channel that is closed once the container has exited.
+func (en *exitNotifier) Wait() <-chan struct{} {
 	return en.c
 }
@@ -0,0 +1,154 @@
+// +build linux
+
+package libcontainerd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTriggerHandleStreamExitCode(t *testing.T) {
+	backend := newFakeBackend()
+	clnt := newTestClient(&fakeAPIClient{}, backend)
+	ctr := newTestContainer(t, clnt, "c1")
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+	clnt.appendContainer(ctr)
+
+	if err := clnt.TriggerHandleStream("c1", InitFriendlyName, 3); err != nil {
+		t.Fatal(err)
+	}
+	st := backend.waitState(t)
+	if st.State != StateExit || st.ExitCode != 3 {
+		t.Fatalf("expected %s with exit code 3, got %+v", StateExit, st)
+	}
+}
+
+func TestListContainersOrder(t *testing.T) {
+	clnt := newTestClient(&fakeAPIClient{}, newFakeBackend())
+	if _, ok := clnt.FirstContainer(); ok {
+		t.Fatal("expected no first container for an empty client")
+	}
+
+	root, err := ioutil.TempDir("", "libcontainerd-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	for _, id := range []string{"c3", "c1", "c2"} {
+		clnt.appendContainer(clnt.newContainer(filepath.Join(root, id)))
+	}
+	// Registering a container again keeps its position.
+	clnt.appendContainer(clnt.newContainer(filepath.Join(root, "c3")))
+
+	if ids := clnt.ListContainers(); !reflect.DeepEqual(ids, []string{"c3", "c1", "c2"}) {
+		t.Fatalf("expected containers in registration order, got %v", ids)
+	}
+	if id, ok := clnt.FirstContainer(); !ok || id != "c3" {
+		t.Fatalf("expected c3 to be the first container, got %q", id)
+	}
+
+	clnt.deleteContainer("c3")
+	if ids := clnt.ListContainers(); !reflect.DeepEqual(ids, []string{"c1", "c2"}) {
+		t.Fatalf("expected c3 to be removed, got %v", ids)
+	}
+	if id, ok := clnt.FirstContainer(); !ok || id != "c1" {
+		t.Fatalf("expected c1 to be the first container, got %q", id)
+	}
+}
+
+func TestPrepareBundleDirConcurrent(t *testing.T) {
+	if os.Getuid() != 0 {
+		t.Skip("chowning bundle dirs requires root")
+	}
+	root, err := ioutil.TempDir("", "libcontainerd-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	// Neither the temp dir nor the missing state dir are world-executable, so
+	// every remapped root gets its own copy of both.
+	defer func() {
+		matches, _ := filepath.Glob(root + ".*")
+		for _, m := range matches {
+			os.RemoveAll(m)
+		}
+	}()
+
+	clnt := newTestClient(&fakeAPIClient{}, newFakeBackend())
+	clnt.remote.stateDir = filepath.Join(root, "state")
+
+	type result struct {
+		uid int
+		dir string
+		err error
+	}
+	results := make(chan result, 20)
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(uid int) {
+			defer wg.Done()
+			dir, err := clnt.prepareBundleDir(uid, uid)
+			results <- result{uid, dir, err}
+		}(1000 + i%4)
+	}
+	wg.Wait()
+	close(results)
+
+	for r := range results {
+		if r.err != nil {
+			t.Fatalf("prepareBundleDir for uid %d failed: %v", r.uid, r.err)
+		}
+		suffix := fmt.Sprintf(".%d.%d", r.uid, r.uid)
+		expected := filepath.Join(root+suffix, "state"+suffix)
+		if r.dir != expected {
+			t.Fatalf("expected bundle dir %s for uid %d, got %s", expected, r.uid, r.dir)
+		}
+		if fi, err := os.Stat(r.dir); err != nil || !fi.IsDir() {
+			t.Fatalf("expected %s to be a directory: %v", r.dir, err)
+		}
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	backend := newFakeBackend()
+	clnt := newTestClient(&fakeAPIClient{}, backend)
+	ctr := newTestContainer(t, clnt, "c1")
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+	clnt.appendContainer(ctr)
+
+	events, cancel := clnt.Subscribe()
+	// A subscriber that never reads must not block event handling.
+	_, cancelSlow := clnt.Subscribe()
+	defer cancelSlow()
+	for i := 0; i < subscriberBuffer; i++ {
+		clnt.publish(StateInfo{})
+	}
+	for i := 0; i < subscriberBuffer; i++ {
+		<-events
+	}
+
+	if err := clnt.TriggerHandleStream("c1", InitFriendlyName, 2); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case st := <-events:
+		if st.State != StateExit || st.ExitCode != 2 {
+			t.Fatalf("expected %s with exit code 2, got %+v", StateExit, st)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the subscriber to be notified")
+	}
+
+	cancel()
+	if _, ok := <-events; ok {
+		t.Fatal("expected the channel to be closed after unsubscribing")
+	}
+	cancel()
+}
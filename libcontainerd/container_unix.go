@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -19,6 +20,9 @@ import (
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/tonistiigi/fifo"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/transport"
 )
 
 type container struct {
@@ -26,12 +30,41 @@ type container struct {
 
 	// Platform specific fields are below here.
 	pauseMonitor
-	oom         bool
-	runtime     string
-	runtimeArgs []string
-//    isBuilding  bool
+	oom            bool
+	runtime        string
+	runtimeArgs    []string
+	startTimeout   time.Duration
+	discardTimeout time.Duration
+	checkpoint     string
+	checkpointDir  string
+	labels         []string
+	// isBuilding keeps the bundle dir of a build container around after it
+	// exits so that its layer can be committed first.
+	isBuilding bool
+	// createAttempts and createBackoff control how CreateContainer is
+	// retried while containerd is unavailable.
+	createAttempts int
+	createBackoff  time.Duration
+	// started is closed once containerd reports the StateStart event of the
+	// init process.
+	started     chan struct{}
+	startedOnce sync.Once
 }
 
+const (
+	// defaultStartTimeout is how long start waits for a newly created
+	// container to report its init process when no timeout has been
+	// configured.
+	defaultStartTimeout = 2 * time.Second
+	// defaultDiscardTimeout is how long discardFifos keeps draining the
+	// container fifos when no timeout has been configured.
+	defaultDiscardTimeout = 3 * time.Second
+	// defaultCreateAttempts and defaultCreateBackoff are used when no create
+	// retry has been configured.
+	defaultCreateAttempts = 3
+	defaultCreateBackoff  = 100 * time.Millisecond
+)
+
 type runtime struct {
 	path string
 	args []string
@@ -50,6 +83,192 @@ func (rt runtime) Apply(p interface{}) error {
 	return nil
 }
 
+type building struct{}
+
+// WithBuilding marks the container as a build container. Its bundle dir is
+// not removed when it exits, so that its layer can be committed first, and
+// has to be removed with CleanupBuild, or is removed when a container with
+// the same ID is created again.
+func WithBuilding() CreateOption {
+	return building{}
+}
+
+func (building) Apply(p interface{}) error {
+	if pr, ok := p.(*container); ok {
+		pr.isBuilding = true
+	}
+	return nil
+}
+
+type checkpoint struct {
+	name string
+	dir  string
+}
+
+// WithCheckpoint sets the checkpoint the container is restored from when it
+// is started. Checkpoint arguments passed to start take precedence.
+func WithCheckpoint(name, dir string) CreateOption {
+	return checkpoint{name, dir}
+}
+
+func (c checkpoint) Apply(p interface{}) error {
+	if pr, ok := p.(*container); ok {
+		pr.checkpoint = c.name
+		pr.checkpointDir = c.dir
+	}
+	return nil
+}
+
+type labels []string
+
+// WithLabels sets labels that are passed to containerd with the create
+// request, e.g. to keep build metadata such as the step index or cache key.
+func WithLabels(l []string) CreateOption {
+	return labels(l)
+}
+
+func (l labels) Apply(p interface{}) error {
+	if pr, ok := p.(*container); ok {
+		pr.labels = append(pr.labels, l...)
+	}
+	return nil
+}
+
+type createRetry struct {
+	attempts int
+	backoff  time.Duration
+}
+
+// WithCreateRetry sets how many times creating the container in containerd is
+// attempted when containerd cannot be reached, and the delay before the first
+// retry. The delay doubles after every attempt.
+func WithCreateRetry(attempts int, backoff time.Duration) CreateOption {
+	return createRetry{attempts, backoff}
+}
+
+func (r createRetry) Apply(p interface{}) error {
+	if pr, ok := p.(*container); ok {
+		pr.createAttempts = r.attempts
+		pr.createBackoff = r.backoff
+	}
+	return nil
+}
+
+type startTimeout time.Duration
+
+// WithStartTimeout sets how long starting the container waits for it to
+// report a running init process. Without it, the LIBCONTAINERD_START_TIMEOUT
+// environment variable or a short default is used.
+func WithStartTimeout(d time.Duration) CreateOption {
+	return startTimeout(d)
+}
+
+func (t startTimeout) Apply(p interface{}) error {
+	if pr, ok := p.(*container); ok {
+		pr.startTimeout = time.Duration(t)
+	}
+	return nil
+}
+
+type discardTimeout time.Duration
+
+// WithDiscardTimeout sets how long the container's stdout and stderr fifos
+// are drained when its output is discarded.
+func WithDiscardTimeout(d time.Duration) CreateOption {
+	return discardTimeout(d)
+}
+
+func (t discardTimeout) Apply(p interface{}) error {
+	if pr, ok := p.(*container); ok {
+		pr.discardTimeout = time.Duration(t)
+	}
+	return nil
+}
+
+func (ctr *container) getDiscardTimeout() time.Duration {
+	if ctr.discardTimeout > 0 {
+		return ctr.discardTimeout
+	}
+	return defaultDiscardTimeout
+}
+
+func (ctr *container) getStartTimeout() time.Duration {
+	if ctr.startTimeout > 0 {
+		return ctr.startTimeout
+	}
+	if v := os.Getenv("LIBCONTAINERD_START_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err == nil && d > 0 {
+			return d
+		}
+		logrus.Warnf("libcontainerd: invalid LIBCONTAINERD_START_TIMEOUT %q, using %v", v, defaultStartTimeout)
+	}
+	return defaultStartTimeout
+}
+
+// createContainer sends the create request to containerd, retrying with an
+// exponential backoff as long as containerd cannot be reached.
+func (ctr *container) createContainer(r *containerd.CreateContainerRequest) (*containerd.CreateContainerResponse, error) {
+	attempts, backoff := ctr.createAttempts, ctr.createBackoff
+	if attempts <= 0 {
+		attempts = defaultCreateAttempts
+	}
+	if backoff <= 0 {
+		backoff = defaultCreateBackoff
+	}
+	for i := 1; ; i++ {
+		resp, err := ctr.client.remote.apiClient.CreateContainer(context.Background(), r)
+		if err == nil || i >= attempts || !isConnectionError(err) {
+			return resp, err
+		}
+		logrus.Warnf("libcontainerd: failed to create container %s (attempt %d/%d), retrying in %v: %v", ctr.containerID, i, attempts, backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// isConnectionError returns whether err means containerd could not be
+// reached, as opposed to having rejected the request.
+func isConnectionError(err error) bool {
+	return grpc.Code(err) == codes.Unavailable ||
+		grpc.ErrorDesc(err) == transport.ErrConnClosing.Desc ||
+		err == grpc.ErrClientConnClosing
+}
+
+// waitStarted waits until containerd reports the start of the container's init
+// process through a StateStart event, or until the start timeout expires.
+func (ctr *container) waitStarted() {
+	timeout := time.NewTimer(ctr.getStartTimeout())
+	defer timeout.Stop()
+	select {
+	case <-ctr.started:
+	case <-timeout.C:
+		logrus.Warnf("libcontainerd: container %s did not report a running process within %v", ctr.containerID, ctr.getStartTimeout())
+		return
+	}
+	if ctr.systemPid != 0 {
+		return
+	}
+	resp, err := ctr.client.remote.apiClient.State(context.Background(), &containerd.StateRequest{Id: ctr.containerID})
+	if err != nil {
+		logrus.Warnf("libcontainerd: failed to get the pid of container %s: %v", ctr.containerID, err)
+		return
+	}
+	for _, cont := range resp.Containers {
+		if cont.Id == ctr.containerID {
+			ctr.systemPid = systemPid(cont)
+		}
+	}
+}
+
+// setStarted records that containerd has started the container's init
+// process, releasing waitStarted.
+func (ctr *container) setStarted() {
+	ctr.startedOnce.Do(func() {
+		close(ctr.started)
+	})
+}
+
 func (ctr *container) clean() error {
 	if os.Getenv("LIBCONTAINERD_NOCLEAN") == "1" {
 		return nil
@@ -67,17 +286,28 @@ func (ctr *container) clean() error {
 	return nil
 }
 
-// cleanProcess removes the fifos used by an additional process.
+// cleanProcess removes the fifos used by an additional process. It returns
+// an error listing the fifos that could not be removed.
 // Caller needs to lock container ID before calling this method.
-func (ctr *container) cleanProcess(id string) {
-	if p, ok := ctr.processes[id]; ok {
-		for _, i := range []int{syscall.Stdin, syscall.Stdout, syscall.Stderr} {
-			if err := os.Remove(p.fifo(i)); err != nil && !os.IsNotExist(err) {
-				logrus.Warnf("libcontainerd: failed to remove %v for process %v: %v", p.fifo(i), id, err)
-			}
+func (ctr *container) cleanProcess(id string) error {
+	defer delete(ctr.processes, id)
+	p, ok := ctr.processes[id]
+	if !ok {
+		return nil
+	}
+	var removed, failed []string
+	for _, i := range []int{syscall.Stdin, syscall.Stdout, syscall.Stderr} {
+		if err := os.Remove(p.fifo(i)); err != nil && !os.IsNotExist(err) {
+			failed = append(failed, fmt.Sprintf("%s: %v", p.fifo(i), err))
+			continue
 		}
+		removed = append(removed, p.fifo(i))
 	}
-	delete(ctr.processes, id)
+	logrus.Debugf("libcontainerd: cleaned fifos of process %v, removed: %v, failed: %v", id, removed, failed)
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to remove fifos for process %s: %s", id, strings.Join(failed, ", "))
+	}
+	return nil
 }
 
 func (ctr *container) spec() (*specs.Spec, error) {
@@ -92,10 +322,34 @@ func (ctr *container) spec() (*specs.Spec, error) {
 	return &spec, nil
 }
 
+// stdinCloseTimeout bounds how long a stdin close requested before the
+// container has started waits for the start to complete.
+var stdinCloseTimeout = 30 * time.Second
+
+// closeStdinWhenReady forwards a stdin close to containerd once the container
+// is ready. It gives up when ctx is done or stdinCloseTimeout expires first.
+func (ctr *container) closeStdinWhenReady(ctx context.Context, ready <-chan struct{}) {
+	timer := time.NewTimer(stdinCloseTimeout)
+	defer timer.Stop()
+	select {
+	case <-ready:
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+	select {
+	case <-ready:
+		if err := ctr.sendCloseStdin(); err != nil {
+			logrus.Warnf("failed to close stdin: %+v", err)
+		}
+	default:
+		logrus.Warnf("libcontainerd: stdin close for %s was never acknowledged, container did not start", ctr.containerID)
+	}
+}
+
 func (ctr *container) start(checkpoint string, checkpointDir string, attachStdio StdioCallback) error {
 	spec, err := ctr.spec()
 	if err != nil {
-		return nil
+		return fmt.Errorf("failed to read container spec for %s: %v", ctr.containerID, err)
 	}
 
     fmt.Println("libcontainerd/container_unix.go     start")
@@ -122,25 +376,20 @@ func (ctr *container) start(checkpoint string, checkpointDir string, attachStdio
 		var err error
 		stdinOnce.Do(func() { // on error from attach we don't know if stdin was already closed
 			err = stdin.Close()
-			go func() {
-				select {
-				case <-ready:
-				case <-ctx.Done():
-				}
-				select {
-				case <-ready:
-					if err := ctr.sendCloseStdin(); err != nil {
-						logrus.Warnf("failed to close stdin: %+v", err)
-					}
-				default:
-				}
-			}()
+			go ctr.closeStdinWhenReady(ctx, ready)
 		})
 		return err
 	})
 
     fmt.Println("libcontainerd/container_unix.go     close stdin")
 
+	if checkpoint == "" {
+		checkpoint = ctr.checkpoint
+	}
+	if checkpointDir == "" {
+		checkpointDir = ctr.checkpointDir
+	}
+
 	r := &containerd.CreateContainerRequest{
 		Id:            ctr.containerID,
 		BundlePath:    ctr.dir,
@@ -149,11 +398,15 @@ func (ctr *container) start(checkpoint string, checkpointDir string, attachStdio
 		Stderr:        ctr.fifo(syscall.Stderr),
 		Checkpoint:    checkpoint,
 		CheckpointDir: checkpointDir,
+		Labels:        ctr.labels,
 		// check to see if we are running in ramdisk to disable pivot root
 		NoPivotRoot: os.Getenv("DOCKER_RAMDISK") != "",
 		Runtime:     ctr.runtime,
 		RuntimeArgs: ctr.runtimeArgs,
 	}
+	// build containers may have their bundle on a ramdisk of their own,
+	// containerd checks it unless the whole daemon runs in a ramdisk
+	r.DetectNoPivotRoot = ctr.isBuilding && !r.NoPivotRoot
 	ctr.client.appendContainer(ctr)
 
     fmt.Println("libcontainered/container_unix.go Create()  attachStdio StdioCallback")
@@ -162,19 +415,16 @@ func (ctr *container) start(checkpoint string, checkpointDir string, attachStdio
 		return err
 	}
 
-	resp, err := ctr.client.remote.apiClient.CreateContainer(context.Background(), r)
+	resp, err := ctr.createContainer(r)
     fmt.Println("libcontainerd/container_unix.go     apiClient CreateContainer")
     if err != nil {
 		ctr.closeFifos(iopipe)
 		return err
 	}
 	ctr.systemPid = systemPid(resp.Container)
+	ctr.waitStarted()
 	close(ready)
 
-    fmt.Println("libcontainerd/container_unix.go     start to sleep 10 seconds")
-    time.Sleep(time.Second * 10)
-    fmt.Println("libcontainerd/container_unix.go     sleep end")
-
 
 	return ctr.client.backend.StateChanged(ctr.containerID, StateInfo{
 		CommonStateInfo: CommonStateInfo{
@@ -196,6 +446,12 @@ func (ctr *container) newProcess(friendlyName string) *process {
 
 func (ctr *container) handleEvent(e *containerd.Event) error {
     fmt.Println("libcontainerd/container_unix.go  handleEvent()")
+	// start() holds the container lock while it waits for this event, the
+	// backend is notified by start() itself.
+	if e.Type == StateStart {
+		ctr.setStarted()
+		return nil
+	}
 	ctr.client.lock(ctr.containerID)
 	defer ctr.client.unlock(ctr.containerID)
 	switch e.Type {
@@ -218,12 +474,21 @@ func (ctr *container) handleEvent(e *containerd.Event) error {
 		// Remove process from list if we have exited
 		switch st.State {
 		case StateExit:
-			ctr.clean()
+			if ctr.isBuilding {
+				ctr.client.keepBuildDir(e.Id, ctr.dir)
+			} else {
+				ctr.waitFifosDrained()
+				ctr.clean()
+			}
 			ctr.client.deleteContainer(e.Id)
             fmt.Println("libcontainerd/container_unix.go/handleEvent()  deleteContainer ", e.Id)
 		case StateExitProcess:
-			ctr.cleanProcess(st.ProcessID)
+			if err := ctr.cleanProcess(st.ProcessID); err != nil {
+				logrus.Warnf("libcontainerd: %v", err)
+			}
 		}
+		ctr.client.publish(st)
+		ctr.client.reportMetrics(e.Id, st)
 		ctr.client.q.append(e.Id, func() {
             fmt.Println("libcontainerd/container_unix.go/handleEvent()  StateChanged")
 			eErr := ctr.client.backend.StateChanged(e.Id, st)
@@ -242,24 +507,80 @@ func (ctr *container) handleEvent(e *containerd.Event) error {
 			}
 		})
 
+	case StateStartProcess:
+		// The init process start is reported through StateStart by start().
+		if e.Pid == InitFriendlyName {
+			break
+		}
+		st := StateInfo{
+			CommonStateInfo: CommonStateInfo{
+				State:     StateStartProcess,
+				ProcessID: e.Pid,
+			},
+		}
+		ctr.client.publish(st)
+		ctr.client.q.append(e.Id, func() {
+			if err := ctr.client.backend.StateChanged(e.Id, st); err != nil {
+				logrus.Errorf("libcontainerd: backend.StateChanged(): %v", err)
+			}
+		})
+
 	default:
 		logrus.Debugf("libcontainerd: event unhandled: %+v", e)
 	}
 	return nil
 }
 
+// waitFifosDrained waits until the output still buffered in the container's
+// stdout and stderr fifos has been consumed by their readers, so that it is
+// not lost when the bundle dir is removed. It gives up once the discard
+// timeout expires.
+func (ctr *container) waitFifosDrained() {
+	deadline := time.Now().Add(ctr.getDiscardTimeout())
+	for _, i := range []int{syscall.Stdout, syscall.Stderr} {
+		fd, err := syscall.Open(ctr.fifo(i), syscall.O_RDONLY|syscall.O_NONBLOCK, 0)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				logrus.Warnf("libcontainerd: error opening fifo %v to check for buffered output: %v", ctr.fifo(i), err)
+			}
+			continue
+		}
+		n, err := fifoBuffered(fd)
+		for err == nil && n > 0 && time.Now().Before(deadline) {
+			time.Sleep(10 * time.Millisecond)
+			n, err = fifoBuffered(fd)
+		}
+		if n > 0 {
+			logrus.Warnf("libcontainerd: %d bytes of output of %s were not read from %v", n, ctr.containerID, ctr.fifo(i))
+		}
+		syscall.Close(fd)
+	}
+}
+
 // discardFifos attempts to fully read the container fifos to unblock processes
-// that may be blocked on the writer side.
-func (ctr *container) discardFifos() {
-	ctx, _ := context.WithTimeout(context.Background(), 3*time.Second)
+// that may be blocked on the writer side. Fifos whose writer has not connected
+// within the discard timeout are given up on. The returned channel is closed
+// once both fifos are done.
+func (ctr *container) discardFifos() <-chan struct{} {
+	ctx, cancel := context.WithTimeout(context.Background(), ctr.getDiscardTimeout())
+	var wg sync.WaitGroup
 	for _, i := range []int{syscall.Stdout, syscall.Stderr} {
 		f, err := fifo.OpenFifo(ctx, ctr.fifo(i), syscall.O_RDONLY|syscall.O_NONBLOCK, 0)
 		if err != nil {
-			logrus.Warnf("error opening fifo %v for discarding: %+v", f, err)
+			logrus.Warnf("error opening fifo %v for discarding: %+v", ctr.fifo(i), err)
 			continue
 		}
+		wg.Add(1)
 		go func() {
+			defer wg.Done()
 			io.Copy(ioutil.Discard, f)
 		}()
 	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		cancel()
+		close(done)
+	}()
+	return done
 }
@@ -0,0 +1,634 @@
+// +build linux
+
+package libcontainerd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	containerd "github.com/docker/containerd/api/grpc/types"
+	"github.com/docker/docker/pkg/locker"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/transport"
+)
+
+type fakeBackend struct {
+	mu       sync.Mutex
+	states   []StateInfo
+	ch       chan StateInfo
+	building bool
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{ch: make(chan StateInfo, 10)}
+}
+
+func (b *fakeBackend) StateChanged(containerID string, state StateInfo) error {
+	b.mu.Lock()
+	b.states = append(b.states, state)
+	b.mu.Unlock()
+	b.ch <- state
+	return nil
+}
+
+func (b *fakeBackend) GetFirstContainerBuildingStatus(id string) bool {
+	return b.building
+}
+
+func (b *fakeBackend) TriggerExitEvent(cId string, exitCode uint32) error {
+	return nil
+}
+
+func (b *fakeBackend) waitState(t *testing.T) StateInfo {
+	select {
+	case st := <-b.ch:
+		return st
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the backend to be notified")
+	}
+	return StateInfo{}
+}
+
+// fakeAPIClient implements the parts of containerd.APIClient used by the
+// tests. Unimplemented methods panic through the nil embedded interface.
+type fakeAPIClient struct {
+	containerd.APIClient
+
+	mu         sync.Mutex
+	createReqs []*containerd.CreateContainerRequest
+	// createErrs are returned by the first CreateContainer calls.
+	createErrs []error
+	signalReqs []*containerd.SignalRequest
+	addReqs    []*containerd.AddProcessRequest
+	// statePid is reported by State after stateCalls calls.
+	statePid   uint32
+	stateAfter int
+	stateCalls int
+	// clnt receives the StateStart event of created containers unless
+	// noStartEvent is set, as containerd would send it.
+	clnt         *client
+	noStartEvent bool
+}
+
+func (c *fakeAPIClient) CreateContainer(ctx context.Context, in *containerd.CreateContainerRequest, opts ...grpc.CallOption) (*containerd.CreateContainerResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.createReqs = append(c.createReqs, in)
+	if len(c.createErrs) > 0 {
+		err := c.createErrs[0]
+		c.createErrs = c.createErrs[1:]
+		return nil, err
+	}
+	if c.clnt != nil && !c.noStartEvent {
+		go c.sendStartEvent(in.Id)
+	}
+	return &containerd.CreateContainerResponse{Container: &containerd.Container{Id: in.Id}}, nil
+}
+
+func (c *fakeAPIClient) sendStartEvent(id string) {
+	ctr, err := c.clnt.getContainer(id)
+	if err != nil {
+		return
+	}
+	ctr.handleEvent(&containerd.Event{Type: StateStart, Id: id, Pid: InitFriendlyName})
+}
+
+func (c *fakeAPIClient) AddProcess(ctx context.Context, in *containerd.AddProcessRequest, opts ...grpc.CallOption) (*containerd.AddProcessResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.addReqs = append(c.addReqs, in)
+	return &containerd.AddProcessResponse{SystemPid: 42}, nil
+}
+
+func (c *fakeAPIClient) Signal(ctx context.Context, in *containerd.SignalRequest, opts ...grpc.CallOption) (*containerd.SignalResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.signalReqs = append(c.signalReqs, in)
+	return &containerd.SignalResponse{}, nil
+}
+
+func (c *fakeAPIClient) State(ctx context.Context, in *containerd.StateRequest, opts ...grpc.CallOption) (*containerd.StateResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stateCalls++
+	cont := &containerd.Container{Id: in.Id}
+	if c.stateCalls > c.stateAfter {
+		cont.Processes = []*containerd.Process{{Pid: InitFriendlyName, SystemPid: c.statePid}}
+	}
+	return &containerd.StateResponse{Containers: []*containerd.Container{cont}}, nil
+}
+
+func (c *fakeAPIClient) UpdateProcess(ctx context.Context, in *containerd.UpdateProcessRequest, opts ...grpc.CallOption) (*containerd.UpdateProcessResponse, error) {
+	return &containerd.UpdateProcessResponse{}, nil
+}
+
+func newTestClient(api containerd.APIClient, backend Backend) *client {
+	clnt := &client{
+		clientCommon: clientCommon{
+			backend:    backend,
+			containers: make(map[string]*container),
+			locker:     locker.New(),
+		},
+		remote:        &remote{apiClient: api},
+		exitNotifiers: make(map[string]*exitNotifier),
+	}
+	if f, ok := api.(*fakeAPIClient); ok {
+		f.clnt = clnt
+	}
+	return clnt
+}
+
+// newTestContainer creates a container with a bundle dir holding a minimal
+// spec, ready to be started.
+func newTestContainer(t *testing.T, clnt *client, id string, options ...CreateOption) *container {
+	root, err := ioutil.TempDir("", "libcontainerd-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctr := clnt.newContainer(filepath.Join(root, id), options...)
+	if err := os.MkdirAll(ctr.dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	dt, err := json.Marshal(specs.Spec{Process: specs.Process{Args: []string{"true"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(ctr.dir, configFilename), dt, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return ctr
+}
+
+func noopAttach(IOPipe) error {
+	return nil
+}
+
+func TestContainerStartWaitsForStartEvent(t *testing.T) {
+	api := &fakeAPIClient{statePid: 42, noStartEvent: true}
+	backend := newFakeBackend()
+	clnt := newTestClient(api, backend)
+	ctr := newTestContainer(t, clnt, "c1", WithStartTimeout(10*time.Second))
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- ctr.start("", "", noopAttach)
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("expected start to wait for the start event, returned %v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	if err := ctr.handleEvent(&containerd.Event{Type: StateStart, Id: "c1", Pid: InitFriendlyName}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected start to return once the start event was received")
+	}
+	st := backend.waitState(t)
+	if st.State != StateStart || st.Pid != 42 {
+		t.Fatalf("expected %s with pid 42, got %+v", StateStart, st)
+	}
+}
+
+func TestContainerStartTimeoutWithoutStartEvent(t *testing.T) {
+	api := &fakeAPIClient{noStartEvent: true}
+	clnt := newTestClient(api, newFakeBackend())
+	ctr := newTestContainer(t, clnt, "c1", WithStartTimeout(100*time.Millisecond))
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	if err := ctr.start("", "", noopAttach); err != nil {
+		t.Fatal(err)
+	}
+	if api.stateCalls != 0 {
+		t.Fatalf("expected no state query without a start event, got %d", api.stateCalls)
+	}
+}
+
+func TestDiscardFifosTimeout(t *testing.T) {
+	clnt := newTestClient(&fakeAPIClient{}, newFakeBackend())
+	ctr := newTestContainer(t, clnt, "c1", WithDiscardTimeout(200*time.Millisecond))
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	if d := ctr.getDiscardTimeout(); d != 200*time.Millisecond {
+		t.Fatalf("expected a discard timeout of 200ms, got %v", d)
+	}
+	for _, i := range []int{syscall.Stdout, syscall.Stderr} {
+		if err := syscall.Mkfifo(ctr.fifo(i), 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// No writer ever connects, so draining only ends with the timeout.
+	start := time.Now()
+	select {
+	case <-ctr.discardFifos():
+	case <-time.After(10 * time.Second):
+		t.Fatal("discardFifos did not finish after its timeout")
+	}
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Fatalf("expected discardFifos to wait for its timeout, finished after %v", elapsed)
+	}
+}
+
+func TestHandleEventStartProcess(t *testing.T) {
+	backend := newFakeBackend()
+	clnt := newTestClient(&fakeAPIClient{}, backend)
+	ctr := newTestContainer(t, clnt, "c1")
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	if err := ctr.handleEvent(&containerd.Event{Type: StateStartProcess, Id: "c1", Pid: InitFriendlyName}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctr.handleEvent(&containerd.Event{Type: StateStartProcess, Id: "c1", Pid: "exec1"}); err != nil {
+		t.Fatal(err)
+	}
+	st := backend.waitState(t)
+	if st.State != StateStartProcess || st.ProcessID != "exec1" {
+		t.Fatalf("expected %s for exec1, got %+v", StateStartProcess, st)
+	}
+	select {
+	case st := <-backend.ch:
+		t.Fatalf("expected a single notification, got %+v", st)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestHandleEventExecExitCode(t *testing.T) {
+	backend := newFakeBackend()
+	clnt := newTestClient(&fakeAPIClient{}, backend)
+	ctr := newTestContainer(t, clnt, "c1")
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	if err := ctr.handleEvent(&containerd.Event{Type: StateExit, Id: "c1", Pid: "exec1", Status: 2}); err != nil {
+		t.Fatal(err)
+	}
+	st := backend.waitState(t)
+	if st.State != StateExitProcess || st.ProcessID != "exec1" || st.ExitCode != 2 {
+		t.Fatalf("expected %s for exec1 with exit code 2, got %+v", StateExitProcess, st)
+	}
+}
+
+func TestHandleEventMetricsHook(t *testing.T) {
+	backend := newFakeBackend()
+	clnt := newTestClient(&fakeAPIClient{}, backend)
+	ctr := newTestContainer(t, clnt, "c1")
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	type call struct {
+		id, state string
+		exitCode  uint32
+	}
+	var calls []call
+	clnt.SetMetricsHook(func(id, state string, exitCode uint32) {
+		calls = append(calls, call{id, state, exitCode})
+	})
+
+	if err := ctr.handleEvent(&containerd.Event{Type: StateExit, Id: "c1", Pid: InitFriendlyName, Status: 137}); err != nil {
+		t.Fatal(err)
+	}
+	backend.waitState(t)
+	if len(calls) != 1 || calls[0] != (call{"c1", StateExit, 137}) {
+		t.Fatalf("expected one %s call for c1 with exit code 137, got %+v", StateExit, calls)
+	}
+}
+
+// fakeEventsClient replays events and then reports a manually closed
+// connection.
+type fakeEventsClient struct {
+	containerd.API_EventsClient
+	events []*containerd.Event
+}
+
+func (c *fakeEventsClient) Recv() (*containerd.Event, error) {
+	if len(c.events) == 0 {
+		return nil, grpc.Errorf(codes.Unavailable, "%s", transport.ErrConnClosing.Desc)
+	}
+	e := c.events[0]
+	c.events = c.events[1:]
+	return e, nil
+}
+
+func TestHandleEventStreamBuildingExecExit(t *testing.T) {
+	backend := newFakeBackend()
+	backend.building = true
+	clnt := newTestClient(&fakeAPIClient{}, backend)
+	clnt.remote.closeManually = true
+	clnt.remote.clients = []*client{clnt}
+	ctr := newTestContainer(t, clnt, "c1", WithBuilding())
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+	clnt.appendContainer(ctr)
+
+	clnt.remote.handleEventStream(&fakeEventsClient{events: []*containerd.Event{
+		{Type: StateExit, Id: "c1", Pid: "exec1", Status: 2},
+		{Type: StateExit, Id: "c1", Pid: InitFriendlyName, Status: 0},
+	}})
+	st := backend.waitState(t)
+	if st.State != StateExitProcess || st.ExitCode != 2 {
+		t.Fatalf("expected the exec exit with code 2, got %+v", st)
+	}
+	select {
+	case st := <-backend.ch:
+		t.Fatalf("expected the init exit of a build container to be left to the builder, got %+v", st)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// the builder reports the init exit with the status of the failed step
+	if err := clnt.TriggerHandleStream("c1", InitFriendlyName, 2); err != nil {
+		t.Fatal(err)
+	}
+	if st := backend.waitState(t); st.State != StateExit || st.ExitCode != 2 {
+		t.Fatalf("expected %s with the step exit code 2, got %+v", StateExit, st)
+	}
+}
+
+func TestContainerStartWithCheckpoint(t *testing.T) {
+	api := &fakeAPIClient{statePid: 42}
+	clnt := newTestClient(api, newFakeBackend())
+	ctr := newTestContainer(t, clnt, "c1", WithCheckpoint("build-step", "/var/lib/checkpoints"))
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	if err := ctr.start("", "", noopAttach); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.createReqs) != 1 {
+		t.Fatalf("expected a single create request, got %d", len(api.createReqs))
+	}
+	r := api.createReqs[0]
+	if r.Checkpoint != "build-step" || r.CheckpointDir != "/var/lib/checkpoints" {
+		t.Fatalf("expected checkpoint build-step in /var/lib/checkpoints, got %q in %q", r.Checkpoint, r.CheckpointDir)
+	}
+}
+
+func TestContainerStartDetectNoPivotRoot(t *testing.T) {
+	api := &fakeAPIClient{statePid: 42}
+	clnt := newTestClient(api, newFakeBackend())
+	for i, options := range [][]CreateOption{nil, {WithBuilding()}} {
+		ctr := newTestContainer(t, clnt, fmt.Sprintf("c%d", i), options...)
+		defer os.RemoveAll(filepath.Dir(ctr.dir))
+		if err := ctr.start("", "", noopAttach); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(api.createReqs) != 2 {
+		t.Fatalf("expected 2 create requests, got %d", len(api.createReqs))
+	}
+	if r := api.createReqs[0]; r.DetectNoPivotRoot {
+		t.Fatalf("expected pivot_root to be set explicitly for a regular container, got %+v", r)
+	}
+	if r := api.createReqs[1]; !r.DetectNoPivotRoot {
+		t.Fatalf("expected pivot_root to be detected for a build container, got %+v", r)
+	}
+}
+
+func TestContainerStartWithLabels(t *testing.T) {
+	api := &fakeAPIClient{statePid: 42}
+	clnt := newTestClient(api, newFakeBackend())
+	ctr := newTestContainer(t, clnt, "c1", WithLabels([]string{"step=3", "cache-key=sha256:abc"}))
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	if err := ctr.start("", "", noopAttach); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.createReqs) != 1 {
+		t.Fatalf("expected a single create request, got %d", len(api.createReqs))
+	}
+	if l := api.createReqs[0].Labels; len(l) != 2 || l[0] != "step=3" || l[1] != "cache-key=sha256:abc" {
+		t.Fatalf("expected the labels to be sent to containerd, got %v", l)
+	}
+}
+
+func TestContainerStartMissingSpec(t *testing.T) {
+	api := &fakeAPIClient{}
+	clnt := newTestClient(api, newFakeBackend())
+	ctr := newTestContainer(t, clnt, "c1")
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	if err := os.Remove(filepath.Join(ctr.dir, configFilename)); err != nil {
+		t.Fatal(err)
+	}
+	err := ctr.start("", "", noopAttach)
+	if err == nil {
+		t.Fatal("expected start to fail without a spec")
+	}
+	if !strings.Contains(err.Error(), "failed to read container spec for c1") {
+		t.Fatalf("expected a spec error, got %v", err)
+	}
+	if len(api.createReqs) != 0 {
+		t.Fatalf("expected no container to be created, got %d requests", len(api.createReqs))
+	}
+}
+
+func TestCloseStdinWhenReadyGivesUp(t *testing.T) {
+	var buf bytes.Buffer
+	logrus.SetOutput(&buf)
+	defer logrus.SetOutput(os.Stderr)
+
+	defer func(d time.Duration) { stdinCloseTimeout = d }(stdinCloseTimeout)
+	stdinCloseTimeout = 100 * time.Millisecond
+
+	clnt := newTestClient(&fakeAPIClient{}, newFakeBackend())
+	ctr := newTestContainer(t, clnt, "c1")
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	run := func(ctx context.Context) {
+		buf.Reset()
+		done := make(chan struct{})
+		go func() {
+			ctr.closeStdinWhenReady(ctx, make(chan struct{}))
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+			t.Fatal("stdin close goroutine did not terminate")
+		}
+		if !strings.Contains(buf.String(), "stdin close for c1 was never acknowledged") {
+			t.Fatalf("expected a warning to be logged, got %q", buf.String())
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	run(ctx)
+	// Without cancellation the timeout ends the wait.
+	run(context.Background())
+}
+
+func TestHandleEventExitKeepsBuildingBundle(t *testing.T) {
+	backend := newFakeBackend()
+	clnt := newTestClient(&fakeAPIClient{}, backend)
+	ctr := newTestContainer(t, clnt, "c1", WithBuilding())
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+	clnt.appendContainer(ctr)
+
+	if err := ctr.handleEvent(&containerd.Event{Type: StateExit, Id: "c1", Pid: InitFriendlyName}); err != nil {
+		t.Fatal(err)
+	}
+	if st := backend.waitState(t); st.State != StateExit {
+		t.Fatalf("expected %s, got %+v", StateExit, st)
+	}
+	if _, err := os.Stat(ctr.dir); err != nil {
+		t.Fatalf("expected the bundle dir to survive the exit, got %v", err)
+	}
+	if _, err := clnt.getContainer("c1"); err == nil {
+		t.Fatal("expected the container to be removed from the client")
+	}
+
+	if err := clnt.CleanupBuild("c1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(ctr.dir); !os.IsNotExist(err) {
+		t.Fatalf("expected the bundle dir to be removed, got %v", err)
+	}
+	if err := clnt.CleanupBuild("c1"); err != nil {
+		t.Fatalf("expected cleaning up twice to be a no-op, got %v", err)
+	}
+}
+
+func TestCleanupBuildKeepsRecreatedContainer(t *testing.T) {
+	backend := newFakeBackend()
+	clnt := newTestClient(&fakeAPIClient{}, backend)
+	ctr := newTestContainer(t, clnt, "c1", WithBuilding())
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+	clnt.appendContainer(ctr)
+
+	if err := ctr.handleEvent(&containerd.Event{Type: StateExit, Id: "c1", Pid: InitFriendlyName}); err != nil {
+		t.Fatal(err)
+	}
+	backend.waitState(t)
+
+	// A container created again with the same ID reuses the bundle dir.
+	clnt.appendContainer(ctr)
+	if err := clnt.CleanupBuild("c1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(ctr.dir); err != nil {
+		t.Fatalf("expected the bundle dir of the active container to be kept, got %v", err)
+	}
+}
+
+func TestContainerStartRetriesCreate(t *testing.T) {
+	unavailable := grpc.Errorf(codes.Unavailable, "containerd is restarting")
+	api := &fakeAPIClient{statePid: 42, createErrs: []error{unavailable, unavailable}}
+	clnt := newTestClient(api, newFakeBackend())
+	ctr := newTestContainer(t, clnt, "c1", WithCreateRetry(3, time.Millisecond))
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	if err := ctr.start("", "", noopAttach); err != nil {
+		t.Fatal(err)
+	}
+	if len(api.createReqs) != 3 {
+		t.Fatalf("expected 3 create attempts, got %d", len(api.createReqs))
+	}
+}
+
+func TestContainerStartCreateErrorNotRetried(t *testing.T) {
+	api := &fakeAPIClient{createErrs: []error{grpc.Errorf(codes.InvalidArgument, "bad spec")}}
+	clnt := newTestClient(api, newFakeBackend())
+	ctr := newTestContainer(t, clnt, "c1", WithCreateRetry(3, time.Millisecond))
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	if err := ctr.start("", "", noopAttach); err == nil {
+		t.Fatal("expected start to fail")
+	}
+	if len(api.createReqs) != 1 {
+		t.Fatalf("expected a single create attempt, got %d", len(api.createReqs))
+	}
+}
+
+func TestCleanProcessReportsFailures(t *testing.T) {
+	clnt := newTestClient(&fakeAPIClient{}, newFakeBackend())
+	ctr := newTestContainer(t, clnt, "c1")
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+
+	p := &process{
+		dir:           ctr.dir,
+		processCommon: processCommon{containerID: "c1", client: clnt, friendlyName: "exec1"},
+	}
+	ctr.processes["exec1"] = p
+	if err := syscall.Mkfifo(p.fifo(syscall.Stdin), 0700); err != nil {
+		t.Fatal(err)
+	}
+	// A non-empty directory in place of the stdout fifo cannot be removed.
+	if err := os.MkdirAll(filepath.Join(p.fifo(syscall.Stdout), "keep"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	err := ctr.cleanProcess("exec1")
+	if err == nil {
+		t.Fatal("expected an error for the fifo that could not be removed")
+	}
+	if !strings.Contains(err.Error(), p.fifo(syscall.Stdout)) || strings.Contains(err.Error(), p.fifo(syscall.Stdin)) {
+		t.Fatalf("expected only the stdout fifo to be reported, got %v", err)
+	}
+	if _, err := os.Stat(p.fifo(syscall.Stdin)); !os.IsNotExist(err) {
+		t.Fatalf("expected the stdin fifo to be removed, got %v", err)
+	}
+	if _, ok := ctr.processes["exec1"]; ok {
+		t.Fatal("expected the process to be removed from the container")
+	}
+}
+
+func TestHandleEventExitWaitsForFifoDrain(t *testing.T) {
+	backend := newFakeBackend()
+	clnt := newTestClient(&fakeAPIClient{}, backend)
+	ctr := newTestContainer(t, clnt, "c1", WithDiscardTimeout(10*time.Second))
+	defer os.RemoveAll(filepath.Dir(ctr.dir))
+	clnt.appendContainer(ctr)
+
+	if err := syscall.Mkfifo(ctr.fifo(syscall.Stdout), 0700); err != nil {
+		t.Fatal(err)
+	}
+	// Holding both ends keeps the written output buffered in the fifo.
+	f, err := os.OpenFile(ctr.fifo(syscall.Stdout), os.O_RDWR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.Write([]byte("build output")); err != nil {
+		t.Fatal(err)
+	}
+
+	// The attached reader only gets to the output after the exit event.
+	read := make(chan string, 1)
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		buf := make([]byte, len("build output"))
+		n, _ := io.ReadFull(f, buf)
+		read <- string(buf[:n])
+	}()
+
+	if err := ctr.handleEvent(&containerd.Event{Type: StateExit, Id: "c1", Pid: InitFriendlyName}); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := fifoBuffered(int(f.Fd())); err != nil || n != 0 {
+		t.Fatalf("expected the fifo to be drained before cleanup, %d bytes left: %v", n, err)
+	}
+	if _, err := os.Stat(ctr.dir); !os.IsNotExist(err) {
+		t.Fatalf("expected the bundle dir to be removed, got %v", err)
+	}
+	if out := <-read; out != "build output" {
+		t.Fatalf("expected the reader to get the whole output, got %q", out)
+	}
+}
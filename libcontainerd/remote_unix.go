@@ -332,7 +332,10 @@ func (r *remote) handleEventStream(events containerd.API_EventsClient) {
         buildingFlag := container.client.backend.GetFirstContainerBuildingStatus(e.Id) 
         fmt.Println("libcontainerd/remote_unix.go handleEventStream() isBuilding ", buildingFlag)
         fmt.Println("libcontainerd/remote_unix.go before handleEvent() Status : ", e.Type)
-        if buildingFlag && e.Type == "exit"{
+        // The init exit of a build container is reported by the builder
+        // through TriggerExitEvent, exits of exec'd processes still carry the
+        // result of a build step.
+        if buildingFlag && e.Type == StateExit && e.Pid == InitFriendlyName {
            fmt.Println("libcontainerd/remote_unix.go handleEventStream() don't handle the exit event!")
            continue
         }
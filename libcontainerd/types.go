@@ -10,13 +10,14 @@ import (
 
 // State constants used in state change reporting.
 const (
-	StateStart       = "start-container"
-	StatePause       = "pause"
-	StateResume      = "resume"
-	StateExit        = "exit"
-	StateRestore     = "restore"
-	StateExitProcess = "exit-process"
-	StateOOM         = "oom" // fake state
+	StateStart        = "start-container"
+	StatePause        = "pause"
+	StateResume       = "resume"
+	StateExit         = "exit"
+	StateRestore      = "restore"
+	StateStartProcess = "start-process"
+	StateExitProcess  = "exit-process"
+	StateOOM          = "oom" // fake state
 )
 
 // CommonStateInfo contains the state info common to all platforms.
@@ -31,13 +32,13 @@ type CommonStateInfo struct { // FIXME: event?
 type Backend interface {
 	StateChanged(containerID string, state StateInfo) error
     GetFirstContainerBuildingStatus(id string) bool
-    TriggerExitEvent(cId string) error 
+    TriggerExitEvent(cId string, exitCode uint32) error
 }
 
 // Client provides access to containerd features.
 type Client interface {
 
-    TriggerHandleStream(cId string) error
+    TriggerHandleStream(cId string, pid string, exitCode uint32) error
 
 	GetServerVersion(ctx context.Context) (*ServerVersion, error)
 	Create(containerID string, checkpoint string, checkpointDir string, spec specs.Spec, attachStdio StdioCallback, options ...CreateOption) error
@@ -55,8 +56,17 @@ type Client interface {
 	CreateCheckpoint(containerID string, checkpointID string, checkpointDir string, exit bool) error
 	DeleteCheckpoint(containerID string, checkpointID string, checkpointDir string) error
 	ListCheckpoints(containerID string, checkpointDir string) (*Checkpoints, error)
+	ListContainers() []string
+	FirstContainer() (string, bool)
+	Subscribe() (<-chan StateInfo, func())
+	CleanupBuild(containerID string) error
 }
 
+// MetricsHook is called with the container ID, state and exit code of each
+// exit, pause, resume and OOM event handled by the client. It is called
+// synchronously while the event is handled and must not block.
+type MetricsHook func(containerID, state string, exitCode uint32)
+
 // CreateOption allows to configure parameters of container creation.
 type CreateOption interface {
 	Apply(interface{}) error
@@ -26,6 +26,10 @@ type Process struct {
 	ApparmorProfile *string `json:"apparmorProfile,omitempty"`
 	// SelinuxLabel specifies the selinux context that the container process is run as.
 	SelinuxLabel *string `json:"selinuxLabel,omitempty"`
+	// RuntimeArgs are passed to the runtime instead of the container's
+	// runtime args when running the process, e.g. for a different seccomp
+	// setup in a build step. The container's are used if empty.
+	RuntimeArgs []string `json:"runtimeArgs,omitempty"`
 }
 
 // StateInfo contains description about the new state container has entered.
@@ -2,6 +2,7 @@ package libcontainerd
 
 import (
 	"syscall"
+	"unsafe"
 
 	containerd "github.com/docker/containerd/api/grpc/types"
 	"github.com/opencontainers/runtime-spec/specs-go"
@@ -60,3 +61,12 @@ func setSysProcAttr(sid bool) *syscall.SysProcAttr {
 		Pdeathsig: syscall.SIGKILL,
 	}
 }
+
+// fifoBuffered returns how many bytes are waiting to be read from the fifo fd.
+func fifoBuffered(fd int) (int, error) {
+	var n int32
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, uintptr(fd), syscall.TIOCINQ, uintptr(unsafe.Pointer(&n))); errno != 0 {
+		return 0, errno
+	}
+	return int(n), nil
+}
@@ -25,3 +25,8 @@ func systemPid(ctr *containerd.Container) uint32 {
 func setSysProcAttr(sid bool) *syscall.SysProcAttr {
 	return nil
 }
+
+// fifoBuffered is not supported on Solaris and always reports an empty fifo.
+func fifoBuffered(fd int) (int, error) {
+	return 0, nil
+}
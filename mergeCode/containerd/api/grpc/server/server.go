@@ -41,6 +41,16 @@ func (s *apiServer) GetServerVersion(ctx context.Context, c *types.GetServerVers
 	}, nil
 }
 
+// startNoPivotRoot returns the NoPivotRoot setting of the start task for c,
+// nil to have the supervisor detect it from the bundle's filesystem.
+func startNoPivotRoot(c *types.CreateContainerRequest) *bool {
+	if c.DetectNoPivotRoot {
+		return nil
+	}
+	noPivotRoot := c.NoPivotRoot
+	return &noPivotRoot
+}
+
 func (s *apiServer) CreateContainer(ctx context.Context, c *types.CreateContainerRequest) (*types.CreateContainerResponse, error) {
 	if c.BundlePath == "" {
 		return nil, errors.New("empty bundle path")
@@ -52,7 +62,7 @@ func (s *apiServer) CreateContainer(ctx context.Context, c *types.CreateContaine
 	e.Stdout = c.Stdout
 	e.Stderr = c.Stderr
 	e.Labels = c.Labels
-	e.NoPivotRoot = c.NoPivotRoot
+	e.NoPivotRoot = startNoPivotRoot(c)
 	e.Runtime = c.Runtime
 	e.RuntimeArgs = c.RuntimeArgs
 	e.StartResponse = make(chan supervisor.StartResponse, 1)
@@ -46,6 +46,7 @@ func (s *apiServer) AddProcess(ctx context.Context, r *types.AddProcessRequest)
 	e.ID = r.Id
 	e.PID = r.Pid
 	e.ProcessSpec = process
+	e.RuntimeArgs = r.RuntimeArgs
 	e.Stdin = r.Stdin
 	e.Stdout = r.Stdout
 	e.Stderr = r.Stderr
@@ -0,0 +1,19 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/docker/containerd/api/grpc/types"
+)
+
+func TestStartNoPivotRoot(t *testing.T) {
+	for _, noPivotRoot := range []bool{false, true} {
+		v := startNoPivotRoot(&types.CreateContainerRequest{NoPivotRoot: noPivotRoot})
+		if v == nil || *v != noPivotRoot {
+			t.Fatalf("expected an explicit NoPivotRoot of %v, got %v", noPivotRoot, v)
+		}
+	}
+	if v := startNoPivotRoot(&types.CreateContainerRequest{NoPivotRoot: true, DetectNoPivotRoot: true}); v != nil {
+		t.Fatalf("expected NoPivotRoot to be left to detection, got %v", *v)
+	}
+}
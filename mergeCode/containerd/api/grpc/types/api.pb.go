@@ -121,17 +121,18 @@ func (*UpdateProcessResponse) ProtoMessage()               {}
 func (*UpdateProcessResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 type CreateContainerRequest struct {
-	Id            string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
-	BundlePath    string   `protobuf:"bytes,2,opt,name=bundlePath" json:"bundlePath,omitempty"`
-	Checkpoint    string   `protobuf:"bytes,3,opt,name=checkpoint" json:"checkpoint,omitempty"`
-	Stdin         string   `protobuf:"bytes,4,opt,name=stdin" json:"stdin,omitempty"`
-	Stdout        string   `protobuf:"bytes,5,opt,name=stdout" json:"stdout,omitempty"`
-	Stderr        string   `protobuf:"bytes,6,opt,name=stderr" json:"stderr,omitempty"`
-	Labels        []string `protobuf:"bytes,7,rep,name=labels" json:"labels,omitempty"`
-	NoPivotRoot   bool     `protobuf:"varint,8,opt,name=noPivotRoot" json:"noPivotRoot,omitempty"`
-	Runtime       string   `protobuf:"bytes,9,opt,name=runtime" json:"runtime,omitempty"`
-	RuntimeArgs   []string `protobuf:"bytes,10,rep,name=runtimeArgs" json:"runtimeArgs,omitempty"`
-	CheckpointDir string   `protobuf:"bytes,11,opt,name=checkpointDir" json:"checkpointDir,omitempty"`
+	Id                string   `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	BundlePath        string   `protobuf:"bytes,2,opt,name=bundlePath" json:"bundlePath,omitempty"`
+	Checkpoint        string   `protobuf:"bytes,3,opt,name=checkpoint" json:"checkpoint,omitempty"`
+	Stdin             string   `protobuf:"bytes,4,opt,name=stdin" json:"stdin,omitempty"`
+	Stdout            string   `protobuf:"bytes,5,opt,name=stdout" json:"stdout,omitempty"`
+	Stderr            string   `protobuf:"bytes,6,opt,name=stderr" json:"stderr,omitempty"`
+	Labels            []string `protobuf:"bytes,7,rep,name=labels" json:"labels,omitempty"`
+	NoPivotRoot       bool     `protobuf:"varint,8,opt,name=noPivotRoot" json:"noPivotRoot,omitempty"`
+	Runtime           string   `protobuf:"bytes,9,opt,name=runtime" json:"runtime,omitempty"`
+	RuntimeArgs       []string `protobuf:"bytes,10,rep,name=runtimeArgs" json:"runtimeArgs,omitempty"`
+	CheckpointDir     string   `protobuf:"bytes,11,opt,name=checkpointDir" json:"checkpointDir,omitempty"`
+	DetectNoPivotRoot bool     `protobuf:"varint,12,opt,name=detectNoPivotRoot" json:"detectNoPivotRoot,omitempty"`
 }
 
 func (m *CreateContainerRequest) Reset()                    { *m = CreateContainerRequest{} }
@@ -190,6 +191,7 @@ type AddProcessRequest struct {
 	SelinuxLabel    string    `protobuf:"bytes,13,opt,name=selinuxLabel" json:"selinuxLabel,omitempty"`
 	NoNewPrivileges bool      `protobuf:"varint,14,opt,name=noNewPrivileges" json:"noNewPrivileges,omitempty"`
 	Rlimits         []*Rlimit `protobuf:"bytes,15,rep,name=rlimits" json:"rlimits,omitempty"`
+	RuntimeArgs     []string  `protobuf:"bytes,16,rep,name=runtimeArgs" json:"runtimeArgs,omitempty"`
 }
 
 func (m *AddProcessRequest) Reset()                    { *m = AddProcessRequest{} }
@@ -1441,168 +1443,169 @@ var _API_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("api.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xec, 0x39, 0xcb, 0x6f, 0x1c, 0x4d,
-	0xf1, 0xd9, 0x87, 0xd7, 0xde, 0xda, 0x87, 0xbd, 0x93, 0xc4, 0xd9, 0x6c, 0x9e, 0xbf, 0xd1, 0xf7,
-	0x83, 0x00, 0x9f, 0x9c, 0xe0, 0x7c, 0x1f, 0x44, 0x20, 0x21, 0x25, 0x76, 0xf8, 0x30, 0x5f, 0x1e,
-	0xce, 0xd8, 0x21, 0x42, 0x42, 0x5a, 0x8d, 0x77, 0x3b, 0xbb, 0x83, 0x67, 0x67, 0xe6, 0x9b, 0xe9,
-	0xf5, 0xe3, 0xc2, 0x81, 0x03, 0xdc, 0xe0, 0x8a, 0xc4, 0x91, 0x1b, 0x77, 0x0e, 0xf0, 0x0f, 0x20,
-	0xf1, 0x87, 0x70, 0xe3, 0xce, 0x91, 0xea, 0xea, 0xc7, 0xf4, 0xec, 0xc3, 0x4e, 0x90, 0x10, 0x17,
-	0x2e, 0xa3, 0xae, 0xea, 0x7a, 0x75, 0x75, 0x55, 0x75, 0x75, 0x0f, 0xd4, 0xfd, 0x24, 0xd8, 0x4a,
-	0xd2, 0x98, 0xc7, 0xce, 0x0a, 0x3f, 0x4f, 0x58, 0xd6, 0xbb, 0x37, 0x8a, 0xe3, 0x51, 0xc8, 0x1e,
-	0x12, 0xf2, 0x68, 0xfa, 0xfe, 0x21, 0x0f, 0x26, 0x2c, 0xe3, 0xfe, 0x24, 0x91, 0x74, 0xee, 0x4d,
-	0xb8, 0xf1, 0x05, 0xe3, 0x07, 0x2c, 0x3d, 0x61, 0xe9, 0x4f, 0x58, 0x9a, 0x05, 0x71, 0xe4, 0xb1,
-	0xaf, 0xa6, 0x48, 0xe3, 0x9e, 0x41, 0x77, 0x7e, 0x2a, 0x4b, 0xe2, 0x28, 0x63, 0xce, 0x35, 0x58,
-	0x99, 0xf8, 0x3f, 0x8f, 0xd3, 0x6e, 0xe9, 0x7e, 0xe9, 0x41, 0xcb, 0x93, 0x00, 0x61, 0x83, 0x08,
-	0xb1, 0x65, 0x85, 0x15, 0x80, 0xc0, 0x26, 0x3e, 0x1f, 0x8c, 0xbb, 0x15, 0x89, 0x25, 0xc0, 0xe9,
-	0xc1, 0x5a, 0xca, 0x4e, 0x02, 0x21, 0xb5, 0x5b, 0xc5, 0x89, 0xba, 0x67, 0x60, 0xf7, 0x57, 0x25,
-	0xb8, 0xf6, 0x36, 0x19, 0xfa, 0x9c, 0xed, 0xa7, 0xf1, 0x80, 0x65, 0x99, 0x32, 0xc9, 0x69, 0x43,
-	0x39, 0x18, 0x92, 0xce, 0xba, 0x87, 0x23, 0x67, 0x03, 0x2a, 0x09, 0x22, 0xca, 0x84, 0x10, 0x43,
-	0xe7, 0x2e, 0xc0, 0x20, 0x8c, 0x33, 0x76, 0xc0, 0x87, 0x41, 0x44, 0x1a, 0xd7, 0x3c, 0x0b, 0x23,
-	0x8c, 0x39, 0x0d, 0x86, 0x7c, 0x4c, 0x3a, 0xd1, 0x18, 0x02, 0x9c, 0x4d, 0xa8, 0x8d, 0x59, 0x30,
-	0x1a, 0xf3, 0xee, 0x0a, 0xa1, 0x15, 0xe4, 0xde, 0x80, 0xeb, 0x33, 0x76, 0xc8, 0xf5, 0xbb, 0x7f,
-	0x2b, 0xc3, 0xe6, 0x4e, 0xca, 0x70, 0x66, 0x27, 0x8e, 0xb8, 0x1f, 0x44, 0x2c, 0x5d, 0x66, 0x23,
-	0x5a, 0x74, 0x34, 0x8d, 0x86, 0x21, 0xdb, 0xf7, 0x51, 0xad, 0x34, 0xd5, 0xc2, 0x90, 0xc5, 0x63,
-	0x36, 0x38, 0x4e, 0xe2, 0x20, 0xe2, 0x64, 0x31, 0xce, 0xe7, 0x18, 0x61, 0x71, 0x46, 0x8b, 0x91,
-	0x5e, 0x92, 0x80, 0xb0, 0x18, 0x07, 0xf1, 0x54, 0x5a, 0x5c, 0xf7, 0x14, 0xa4, 0xf0, 0x2c, 0x4d,
-	0xbb, 0x35, 0x83, 0x47, 0x48, 0xe0, 0x43, 0xff, 0x88, 0x85, 0x59, 0x77, 0xf5, 0x7e, 0x45, 0xe0,
-	0x25, 0xe4, 0xdc, 0x87, 0x46, 0x14, 0xef, 0x07, 0x27, 0x31, 0xf7, 0xe2, 0x98, 0x77, 0xd7, 0xc8,
-	0x61, 0x36, 0xca, 0xe9, 0xc2, 0x6a, 0x3a, 0x8d, 0x44, 0xdc, 0x74, 0xeb, 0x24, 0x52, 0x83, 0x82,
-	0x57, 0x0d, 0x9f, 0xa6, 0xa3, 0xac, 0x0b, 0x24, 0xd8, 0x46, 0x39, 0x9f, 0x40, 0x2b, 0x5f, 0xc9,
-	0x6e, 0x90, 0x76, 0x1b, 0x24, 0xa1, 0x88, 0x74, 0xf7, 0xe0, 0xc6, 0x9c, 0x2f, 0x55, 0x9c, 0x6d,
-	0x41, 0x7d, 0xa0, 0x91, 0xe4, 0xd3, 0xc6, 0xf6, 0xc6, 0x16, 0x85, 0xf6, 0x56, 0x4e, 0x9c, 0x93,
-	0xa0, 0xa8, 0xd6, 0x41, 0x30, 0x8a, 0xfc, 0xf0, 0xc3, 0x23, 0x46, 0x78, 0x8c, 0x58, 0x54, 0x7c,
-	0x2a, 0xc8, 0xdd, 0x80, 0xb6, 0x16, 0xa5, 0x36, 0xfd, 0x4f, 0x15, 0xe8, 0x3c, 0x1d, 0x0e, 0x2f,
-	0x89, 0x49, 0x0c, 0x6c, 0xce, 0x52, 0x0c, 0x7d, 0x94, 0x58, 0x26, 0x77, 0x1a, 0xd8, 0xb9, 0x07,
-	0xd5, 0x69, 0x86, 0x2b, 0xa9, 0xd0, 0x4a, 0x1a, 0x6a, 0x25, 0x6f, 0x11, 0xe5, 0xd1, 0x84, 0xe3,
-	0x40, 0xd5, 0x17, 0xbe, 0xac, 0x92, 0x2f, 0x69, 0x2c, 0x4c, 0x66, 0xd1, 0x09, 0xee, 0xb3, 0x40,
-	0x89, 0xa1, 0xc0, 0x0c, 0x4e, 0x87, 0x6a, 0x87, 0xc5, 0x50, 0x2f, 0x6b, 0x35, 0x5f, 0x96, 0x09,
-	0x9b, 0xb5, 0xc5, 0x61, 0x53, 0x5f, 0x12, 0x36, 0x50, 0x08, 0x1b, 0x17, 0x9a, 0x03, 0x3f, 0xf1,
-	0x8f, 0x82, 0x30, 0xe0, 0x01, 0xcb, 0x70, 0xff, 0x84, 0x11, 0x05, 0x9c, 0xf3, 0x00, 0xd6, 0xfd,
-	0x24, 0xf1, 0xd3, 0x49, 0x9c, 0xa2, 0x6b, 0xde, 0x07, 0x21, 0xeb, 0x36, 0x49, 0xc8, 0x2c, 0x5a,
-	0x48, 0xcb, 0x58, 0x18, 0x44, 0xd3, 0xb3, 0x17, 0x22, 0xfa, 0xba, 0x2d, 0x22, 0x2b, 0xe0, 0x84,
-	0xb4, 0x28, 0x7e, 0xc5, 0x4e, 0xf7, 0xd3, 0xe0, 0x04, 0x79, 0x46, 0xa8, 0xb4, 0x4d, 0x5e, 0x9c,
-	0x45, 0x3b, 0x5f, 0xc7, 0xc0, 0x0c, 0x83, 0x49, 0xc0, 0xb3, 0xee, 0x3a, 0x9a, 0xd5, 0xd8, 0x6e,
-	0x29, 0x7f, 0x7a, 0x84, 0xf5, 0xf4, 0xac, 0xbb, 0x0b, 0x35, 0x89, 0x12, 0xee, 0x15, 0x24, 0x6a,
-	0xb7, 0x68, 0x2c, 0x70, 0x59, 0xfc, 0x9e, 0xd3, 0x5e, 0x55, 0x3d, 0x1a, 0x0b, 0xdc, 0xd8, 0x4f,
-	0x87, 0xb4, 0x4f, 0x88, 0x13, 0x63, 0xd7, 0x83, 0xaa, 0xd8, 0x28, 0xe1, 0xea, 0xa9, 0xda, 0xf0,
-	0x96, 0x27, 0x86, 0x02, 0x33, 0x52, 0x31, 0x85, 0x18, 0x1c, 0x3a, 0x5f, 0x83, 0xb6, 0x3f, 0x1c,
-	0xa2, 0x7b, 0x62, 0xdc, 0xf5, 0x2f, 0x82, 0x61, 0x86, 0x92, 0x2a, 0x38, 0x39, 0x83, 0x75, 0xb7,
-	0xc1, 0xb1, 0x03, 0x4a, 0x05, 0xfd, 0x6d, 0xa8, 0x67, 0xe7, 0x19, 0x67, 0x93, 0x7d, 0xa3, 0x27,
-	0x47, 0xb8, 0xbf, 0x2c, 0x99, 0x74, 0x31, 0x59, 0xb4, 0x2c, 0x16, 0xbf, 0x5d, 0xa8, 0x2d, 0x65,
-	0x8a, 0xba, 0x8e, 0xce, 0x9f, 0x9c, 0xdb, 0x2e, 0x37, 0x73, 0x29, 0x5b, 0x59, 0x94, 0xb2, 0x3d,
-	0xe8, 0xce, 0xdb, 0xa0, 0xd2, 0x64, 0x00, 0x37, 0x76, 0x59, 0xc8, 0x3e, 0xc4, 0x3e, 0xf4, 0x73,
-	0xe4, 0x63, 0x61, 0x91, 0xe9, 0x48, 0xe3, 0x0f, 0x37, 0x60, 0x5e, 0x89, 0x32, 0xe0, 0x25, 0x5c,
-	0x7f, 0x11, 0x64, 0xfc, 0x72, 0xf5, 0x73, 0xaa, 0xca, 0x8b, 0x54, 0xfd, 0xae, 0x04, 0x90, 0xcb,
-	0x32, 0x36, 0x97, 0x2c, 0x9b, 0x11, 0xc7, 0xce, 0x02, 0xae, 0xf2, 0x9d, 0xc6, 0x22, 0x2a, 0xf8,
-	0x20, 0x51, 0x47, 0x90, 0x18, 0x8a, 0x7a, 0x39, 0x8d, 0x82, 0xb3, 0x83, 0x78, 0x70, 0xcc, 0x78,
-	0x46, 0xf5, 0x1c, 0x6b, 0xad, 0x85, 0xa2, 0xa4, 0x1d, 0xb3, 0x30, 0xa4, 0xa2, 0xbe, 0xe6, 0x49,
-	0x40, 0x54, 0x60, 0x36, 0x49, 0xf8, 0xf9, 0xab, 0x03, 0x4c, 0x79, 0x91, 0x7f, 0x1a, 0xc4, 0x95,
-	0x6e, 0xce, 0xae, 0x54, 0xc5, 0xd0, 0x63, 0x68, 0xe4, 0xab, 0xc8, 0xd0, 0xd8, 0xca, 0xe2, 0xad,
-	0xb7, 0xa9, 0xdc, 0xbb, 0xd0, 0x3c, 0xe0, 0xb8, 0xa9, 0x4b, 0xfc, 0xe5, 0x3e, 0x80, 0xb6, 0xa9,
-	0xba, 0x44, 0x28, 0xeb, 0x86, 0xcf, 0xa7, 0x99, 0xa2, 0x52, 0x90, 0xfb, 0xe7, 0x0a, 0xac, 0xaa,
-	0xb0, 0xd6, 0xb5, 0xa9, 0x94, 0xd7, 0xa6, 0xff, 0x4a, 0x89, 0x2c, 0x64, 0xd5, 0xea, 0x4c, 0x56,
-	0xfd, 0xaf, 0x5c, 0xe6, 0xe5, 0xf2, 0xaf, 0x25, 0xa8, 0x9b, 0x6d, 0xfe, 0xe8, 0x76, 0xe6, 0x53,
-	0xa8, 0x27, 0x72, 0xe3, 0x99, 0xac, 0x7a, 0x8d, 0xed, 0xb6, 0x52, 0xa4, 0xeb, 0x5c, 0x4e, 0x60,
-	0xc5, 0x4f, 0xd5, 0x8e, 0x1f, 0xab, 0x5d, 0x59, 0x29, 0xb4, 0x2b, 0xb8, 0xf9, 0x89, 0x28, 0xa7,
-	0x35, 0x2a, 0xa7, 0x34, 0xb6, 0x1b, 0x94, 0xd5, 0x42, 0x83, 0xe2, 0x7e, 0x0e, 0xab, 0x2f, 0xfd,
-	0xc1, 0x18, 0xd7, 0x21, 0x18, 0x07, 0x89, 0x0a, 0x53, 0x64, 0x14, 0x63, 0xa1, 0x64, 0xc2, 0xd0,
-	0xdf, 0xe7, 0xaa, 0xf6, 0x2b, 0xc8, 0x3d, 0xc6, 0x26, 0x42, 0xa6, 0x81, 0x4a, 0xa6, 0x47, 0x58,
-	0x46, 0xb5, 0x43, 0x74, 0x2e, 0xcd, 0xb7, 0x21, 0x16, 0x0d, 0x6e, 0xcb, 0xea, 0x44, 0x6a, 0x56,
-	0x55, 0x57, 0xfb, 0x40, 0xd9, 0xe3, 0xe9, 0x69, 0xf7, 0xd7, 0x25, 0xd8, 0x94, 0x3d, 0xe6, 0xa5,
-	0x9d, 0xe4, 0xe2, 0xde, 0x45, 0xba, 0xaf, 0x52, 0x70, 0xdf, 0x63, 0xa8, 0xa7, 0x2c, 0x8b, 0xa7,
-	0x29, 0xba, 0x99, 0x3c, 0xdb, 0xd8, 0xbe, 0xae, 0x33, 0x89, 0x74, 0x79, 0x6a, 0xd6, 0xcb, 0xe9,
-	0xdc, 0x7f, 0xd4, 0xa0, 0x5d, 0x9c, 0x15, 0x15, 0xeb, 0x28, 0x3c, 0x0e, 0xe2, 0x77, 0xb2, 0x39,
-	0x2e, 0x91, 0x9b, 0x6c, 0x94, 0xc8, 0x2a, 0xf4, 0xe5, 0x01, 0x9e, 0x90, 0xa8, 0x49, 0xba, 0x31,
-	0x47, 0xa8, 0xd9, 0x7d, 0x96, 0x06, 0xb1, 0x3e, 0x4c, 0x73, 0x84, 0x28, 0x03, 0x08, 0xbc, 0x99,
-	0xc6, 0xdc, 0x27, 0x23, 0xab, 0x9e, 0x81, 0xa9, 0x2b, 0xc6, 0x3d, 0x62, 0x7c, 0x47, 0xec, 0xda,
-	0x8a, 0xea, 0x8a, 0x0d, 0x26, 0x9f, 0x7f, 0xc9, 0x26, 0x99, 0x4a, 0x73, 0x0b, 0x23, 0x2c, 0x97,
-	0xbb, 0xf9, 0x42, 0x04, 0x35, 0x05, 0x06, 0x5a, 0x6e, 0xa1, 0x84, 0x04, 0x09, 0x1e, 0x9c, 0xfa,
-	0x09, 0xa5, 0x7d, 0xd5, 0xb3, 0x30, 0x18, 0xc8, 0x1d, 0x09, 0xa1, 0x37, 0xf0, 0x0e, 0xe4, 0x8b,
-	0x63, 0x9b, 0xca, 0x40, 0xd5, 0x9b, 0x9f, 0x10, 0xd4, 0xc7, 0x2c, 0x8d, 0x58, 0xf8, 0xd2, 0xd2,
-	0x0a, 0x92, 0x7a, 0x6e, 0xc2, 0xd9, 0x86, 0x6b, 0x12, 0x79, 0xb8, 0xb3, 0x6f, 0x33, 0x34, 0x88,
-	0x61, 0xe1, 0x9c, 0xc8, 0x74, 0x72, 0xfc, 0x0b, 0xe6, 0xbf, 0x57, 0xfb, 0xd1, 0x24, 0xf2, 0x59,
-	0xb4, 0xf3, 0x14, 0x3a, 0xd6, 0x16, 0xed, 0xe2, 0xad, 0x6a, 0xc0, 0xb0, 0x78, 0x88, 0xa8, 0xbd,
-	0xaa, 0xa2, 0xc0, 0x9e, 0xf2, 0xe6, 0xa9, 0x9d, 0xb7, 0xd0, 0x23, 0xe4, 0xe1, 0x18, 0x6f, 0x89,
-	0x3c, 0xc4, 0x88, 0xf0, 0x87, 0xcf, 0x92, 0x4c, 0xc9, 0x6a, 0x93, 0x2c, 0x1d, 0x51, 0x9a, 0x46,
-	0x49, 0xbb, 0x80, 0xd1, 0x79, 0x07, 0xb7, 0x0a, 0xb3, 0xef, 0xd2, 0x80, 0xb3, 0x5c, 0xee, 0xfa,
-	0x45, 0x72, 0x2f, 0xe2, 0x9c, 0x13, 0x2c, 0xd4, 0xee, 0xc5, 0x46, 0xf0, 0xc6, 0x87, 0x0b, 0x2e,
-	0x72, 0x3a, 0x3f, 0x85, 0xdb, 0xf3, 0x7a, 0x2d, 0xc9, 0x9d, 0x8b, 0x24, 0x5f, 0xc8, 0xea, 0x7e,
-	0x1f, 0x5a, 0xcf, 0x42, 0x3c, 0xf8, 0xf7, 0x5e, 0x2b, 0x5d, 0x85, 0x4b, 0x75, 0x65, 0xe1, 0xa5,
-	0xba, 0xa2, 0x2e, 0xd5, 0xee, 0x2f, 0xa0, 0x59, 0xd8, 0xb0, 0xef, 0x50, 0xa6, 0x6a, 0x51, 0xea,
-	0xaa, 0x74, 0x4d, 0x99, 0x55, 0x50, 0xe3, 0xd9, 0x84, 0xa2, 0x82, 0x9c, 0xca, 0x60, 0x92, 0xed,
-	0xab, 0x82, 0x44, 0x76, 0x84, 0x79, 0xa0, 0xc9, 0x9b, 0x91, 0x85, 0x71, 0x7f, 0x06, 0xed, 0xe2,
-	0x62, 0xff, 0x6d, 0x0b, 0xb0, 0x32, 0xa7, 0x58, 0x73, 0x74, 0xff, 0x2d, 0xc6, 0xe2, 0x55, 0x62,
-	0xae, 0x26, 0xaa, 0xe6, 0xee, 0x1c, 0x5a, 0xcf, 0x4f, 0x18, 0x76, 0x2b, 0xba, 0x4a, 0x3e, 0x81,
-	0xba, 0x79, 0xd4, 0x50, 0xc5, 0xb6, 0xb7, 0x25, 0x9f, 0x3d, 0xb6, 0xf4, 0xb3, 0xc7, 0xd6, 0xa1,
-	0xa6, 0xf0, 0x72, 0x62, 0xb1, 0xc6, 0x8c, 0xc7, 0x29, 0x1b, 0xbe, 0x8e, 0xc2, 0x73, 0xfd, 0x56,
-	0x90, 0x63, 0x54, 0xfd, 0xad, 0x9a, 0xf6, 0xe7, 0xb7, 0x25, 0x58, 0x21, 0xdd, 0x0b, 0xef, 0x11,
-	0x92, 0xba, 0x6c, 0xaa, 0x75, 0xb1, 0x36, 0xb7, 0x4c, 0x6d, 0x56, 0x55, 0xbc, 0x9a, 0x57, 0xf1,
-	0xc2, 0x0a, 0x6a, 0x1f, 0xb1, 0x02, 0xf7, 0x37, 0x65, 0x68, 0xbe, 0x62, 0xfc, 0x34, 0x4e, 0x8f,
-	0xc5, 0x89, 0x95, 0x2d, 0x6c, 0x4e, 0x6f, 0xc2, 0x5a, 0x7a, 0xd6, 0x3f, 0x3a, 0xe7, 0xa6, 0x42,
-	0xaf, 0xa6, 0x67, 0xcf, 0x04, 0xe8, 0xdc, 0x01, 0xc0, 0xa9, 0x7d, 0x5f, 0x36, 0xa4, 0xaa, 0x40,
-	0xa7, 0x67, 0x0a, 0xe1, 0xdc, 0x82, 0xba, 0x77, 0xd6, 0xc7, 0xc6, 0x26, 0x4e, 0x33, 0x5d, 0xa1,
-	0xd3, 0xb3, 0xe7, 0x04, 0x0b, 0x5e, 0x9c, 0x1c, 0xa6, 0x71, 0x92, 0xb0, 0x21, 0x55, 0x68, 0xe2,
-	0xdd, 0x95, 0x08, 0xa1, 0xf5, 0x50, 0x6b, 0xad, 0x49, 0xad, 0x3c, 0xd7, 0x8a, 0x53, 0x89, 0xd2,
-	0x2a, 0x4b, 0x73, 0x9d, 0xdb, 0x5a, 0x0f, 0x8d, 0x56, 0x59, 0x97, 0xd7, 0xb8, 0xa5, 0xf5, 0x30,
-	0xd7, 0x5a, 0xd7, 0xbc, 0x4a, 0xab, 0xfb, 0xc7, 0x12, 0xac, 0xe1, 0xf9, 0xf0, 0x36, 0xf3, 0x47,
-	0x0c, 0x5b, 0xc9, 0x06, 0xc7, 0xb3, 0x24, 0xec, 0x4f, 0x05, 0xa8, 0x4e, 0x2f, 0x20, 0x94, 0x24,
-	0xf8, 0x3f, 0x68, 0x26, 0x2c, 0xc5, 0x53, 0x43, 0x51, 0x94, 0x31, 0x99, 0xf1, 0x94, 0x90, 0x38,
-	0x49, 0xb2, 0x05, 0x57, 0x69, 0xae, 0x1f, 0x44, 0x7d, 0x59, 0x96, 0x27, 0xf1, 0x90, 0x29, 0x57,
-	0x75, 0x68, 0x6a, 0x2f, 0xfa, 0xd2, 0x4c, 0x38, 0xdf, 0x84, 0x8e, 0xa1, 0x17, 0xed, 0x2a, 0x51,
-	0x4b, 0xd7, 0xad, 0x2b, 0xea, 0xb7, 0x0a, 0x8d, 0x39, 0xac, 0x73, 0x28, 0x88, 0x46, 0xbb, 0x3e,
-	0x9e, 0x7a, 0xd8, 0xca, 0x24, 0x74, 0x36, 0x66, 0xca, 0x5a, 0x0d, 0x3a, 0xdf, 0x82, 0x0e, 0x57,
-	0xf9, 0x36, 0xec, 0x6b, 0x1a, 0xb9, 0x9b, 0x1b, 0x66, 0x62, 0x5f, 0x11, 0xff, 0x3f, 0xb4, 0x73,
-	0x62, 0x6a, 0x8c, 0xa4, 0xbd, 0x2d, 0x83, 0x15, 0xd1, 0xe4, 0xfe, 0x5e, 0x3a, 0x4b, 0x46, 0xce,
-	0xa7, 0x74, 0x54, 0x5b, 0xae, 0x6a, 0x6c, 0xaf, 0xeb, 0x16, 0x47, 0x39, 0x83, 0x8e, 0x67, 0xe9,
-	0x96, 0x1f, 0xc0, 0x3a, 0x37, 0xa6, 0xf7, 0x31, 0x53, 0x7d, 0x95, 0x7a, 0x33, 0x95, 0x50, 0x2d,
-	0xcc, 0x6b, 0xf3, 0xe2, 0x42, 0xd1, 0xf3, 0xb2, 0xf7, 0x56, 0x0a, 0xa5, 0x7d, 0x0d, 0x89, 0x23,
-	0x15, 0x58, 0x1e, 0xeb, 0xd8, 0x98, 0x67, 0xd2, 0x3a, 0x74, 0xcc, 0x60, 0x9a, 0xa6, 0x98, 0x7b,
-	0xda, 0x31, 0x0a, 0x14, 0xe5, 0x91, 0xfa, 0x56, 0xe5, 0x0c, 0x09, 0xb8, 0x31, 0x80, 0x3c, 0x3b,
-	0x49, 0x1b, 0xd2, 0xd8, 0x21, 0x20, 0x01, 0x11, 0x67, 0x13, 0xff, 0xcc, 0x6c, 0x3d, 0xc5, 0x19,
-	0x22, 0xe4, 0x02, 0x51, 0xe1, 0x7b, 0x3f, 0x08, 0x07, 0xea, 0x49, 0x0e, 0x15, 0x2a, 0x30, 0x57,
-	0x58, 0xb5, 0x15, 0xfe, 0xa1, 0x0c, 0x0d, 0xa9, 0x51, 0x1a, 0x8c, 0x54, 0x03, 0xec, 0xf0, 0x8c,
-	0x4a, 0x02, 0xb0, 0x07, 0x5f, 0xc9, 0xd5, 0xe5, 0xf7, 0xb1, 0xdc, 0x54, 0x6d, 0x1b, 0x76, 0x9c,
-	0x19, 0x36, 0x21, 0x96, 0x77, 0x16, 0x52, 0xd7, 0x05, 0x91, 0x34, 0xf8, 0x33, 0x68, 0xca, 0xf8,
-	0x54, 0x3c, 0xd5, 0x65, 0x3c, 0x0d, 0x49, 0x26, 0xb9, 0x1e, 0x8b, 0x6b, 0x0f, 0xda, 0x4b, 0x6d,
-	0x76, 0x63, 0xfb, 0x4e, 0x81, 0x9c, 0x56, 0xb2, 0x45, 0xdf, 0xe7, 0x11, 0xc7, 0x7e, 0x47, 0xd2,
-	0xf6, 0x9e, 0x00, 0xe4, 0x48, 0x51, 0xcf, 0x8e, 0xd9, 0xb9, 0xbe, 0xde, 0xe1, 0x50, 0xac, 0xfd,
-	0xc4, 0x0f, 0xa7, 0xda, 0xa9, 0x12, 0xf8, 0x5e, 0xf9, 0x49, 0xc9, 0x1d, 0xc0, 0xfa, 0x33, 0x71,
-	0x24, 0x5a, 0xec, 0x85, 0x43, 0xaf, 0xba, 0xf0, 0xd0, 0xab, 0xea, 0x97, 0x64, 0x2c, 0xb1, 0x71,
-	0xa2, 0x5a, 0x5d, 0x1c, 0xe5, 0x8a, 0xaa, 0x96, 0x22, 0xf7, 0xef, 0x55, 0x80, 0x5c, 0x8b, 0x73,
-	0x00, 0xbd, 0x20, 0xee, 0x8b, 0x4e, 0x0d, 0x4f, 0x1b, 0x59, 0x90, 0xfa, 0x29, 0xc3, 0xf0, 0xc9,
-	0x82, 0x13, 0xa6, 0x9a, 0xf9, 0x4d, 0x73, 0x4c, 0x15, 0x8c, 0xf3, 0x6e, 0x20, 0x24, 0x19, 0xa9,
-	0x72, 0x79, 0x9a, 0xcd, 0xf9, 0x31, 0x5c, 0xcf, 0x85, 0x0e, 0x2d, 0x79, 0xe5, 0x0b, 0xe5, 0x5d,
-	0x35, 0xf2, 0x86, 0xb9, 0xac, 0x1f, 0x02, 0xa2, 0xfb, 0x78, 0x98, 0x4d, 0x0b, 0x92, 0x2a, 0x17,
-	0x4a, 0xea, 0x04, 0xf1, 0x1b, 0xe2, 0xc8, 0xe5, 0xbc, 0x81, 0x9b, 0xd6, 0x42, 0x45, 0xda, 0x5b,
-	0xd2, 0xaa, 0x17, 0x4a, 0xdb, 0x34, 0x76, 0x89, 0xc2, 0x90, 0x8b, 0xfc, 0x12, 0x70, 0xa6, 0x7f,
-	0xea, 0x07, 0x7c, 0x56, 0xde, 0xca, 0x65, 0xeb, 0x7c, 0x87, 0x4c, 0x45, 0x61, 0x72, 0x9d, 0x13,
-	0x96, 0x8e, 0x0a, 0xeb, 0xac, 0x5d, 0xb6, 0xce, 0x97, 0xc4, 0x91, 0xcb, 0x79, 0x06, 0x88, 0x9c,
-	0xb5, 0x67, 0xf5, 0x42, 0x29, 0xeb, 0xd8, 0x85, 0x15, 0x6c, 0xd9, 0x81, 0x4e, 0xc6, 0x06, 0x78,
-	0xd4, 0xdb, 0xb1, 0xb0, 0x76, 0xa1, 0x8c, 0x0d, 0xc5, 0x60, 0x84, 0xb8, 0x5f, 0x41, 0xf3, 0x47,
-	0xd3, 0x11, 0xe3, 0xe1, 0x91, 0xc9, 0xf9, 0xff, 0x74, 0x99, 0xf9, 0x27, 0x96, 0x99, 0x9d, 0x51,
-	0x1a, 0x4f, 0x93, 0x42, 0xd5, 0x96, 0x39, 0x3c, 0x57, 0xb5, 0x89, 0x86, 0xaa, 0xb6, 0xa4, 0xfe,
-	0x1c, 0x9a, 0xf2, 0xe6, 0xa2, 0x18, 0x64, 0x15, 0x72, 0xe6, 0x93, 0x5e, 0xdf, 0x94, 0x24, 0xdb,
-	0xb6, 0xba, 0x05, 0x2a, 0xae, 0x62, 0x35, 0xca, 0xdd, 0xe4, 0xc1, 0x51, 0x9e, 0x75, 0x7b, 0xd0,
-	0x1a, 0x4b, 0xdf, 0x28, 0x2e, 0x19, 0x80, 0x9f, 0x68, 0xe3, 0xf2, 0x35, 0x6c, 0xd9, 0x3e, 0x94,
-	0xae, 0x6e, 0x8e, 0x6d, 0xb7, 0x3e, 0x04, 0x10, 0xf7, 0xfc, 0xbe, 0x2e, 0x54, 0xf6, 0x4f, 0x00,
-	0x73, 0x42, 0x78, 0xf5, 0x44, 0x0f, 0x7b, 0x87, 0xd0, 0x99, 0x93, 0xb9, 0xa0, 0x4c, 0x7d, 0xc3,
-	0x2e, 0x53, 0xf9, 0xd5, 0xc8, 0x66, 0xb5, 0x6b, 0xd7, 0x5f, 0x4a, 0xf2, 0x59, 0x20, 0x7f, 0xa7,
-	0x7d, 0x02, 0xad, 0x48, 0x36, 0x5f, 0x66, 0x03, 0xec, 0x3b, 0x96, 0xdd, 0x98, 0x79, 0xcd, 0xc8,
-	0x6e, 0xd3, 0x70, 0x23, 0x06, 0xe4, 0x81, 0x85, 0x1b, 0x61, 0x39, 0xc7, 0x6b, 0x0c, 0xac, 0xdd,
-	0x2e, 0x34, 0x8a, 0xd5, 0x8f, 0x69, 0x14, 0xd5, 0xcb, 0xde, 0xb2, 0x9f, 0x16, 0xdb, 0x78, 0xf7,
-	0xaf, 0x3c, 0xdd, 0xdf, 0xc3, 0x7b, 0xdf, 0xc6, 0xec, 0x3f, 0x3f, 0xe7, 0xae, 0x32, 0x6b, 0xc9,
-	0x7f, 0xc2, 0xde, 0xbd, 0xa5, 0xf3, 0xaa, 0x65, 0xbf, 0xe2, 0x78, 0xb0, 0x3e, 0xf3, 0x87, 0xc7,
-	0xd1, 0x47, 0xcd, 0xe2, 0xbf, 0x68, 0xbd, 0xbb, 0xcb, 0xa6, 0x6d, 0x99, 0x33, 0x77, 0x04, 0x23,
-	0x73, 0xf1, 0x7b, 0x8a, 0x91, 0xb9, 0xec, 0x6a, 0x71, 0xc5, 0xf9, 0x2e, 0xd4, 0xe4, 0x3f, 0x1f,
-	0x47, 0x5f, 0x5c, 0x0a, 0x7f, 0x93, 0x7a, 0xd7, 0x67, 0xb0, 0x86, 0xf1, 0x05, 0xb4, 0x0a, 0x3f,
-	0x0a, 0x9d, 0x5b, 0x05, 0x5d, 0xc5, 0x5f, 0x46, 0xbd, 0xdb, 0x8b, 0x27, 0x8d, 0xb4, 0x1d, 0x80,
-	0xfc, 0xb7, 0x80, 0xd3, 0x55, 0xd4, 0x73, 0xbf, 0x9e, 0x7a, 0x37, 0x17, 0xcc, 0x18, 0x21, 0xb8,
-	0x95, 0xb3, 0x4f, 0xf4, 0xce, 0x8c, 0x57, 0x67, 0x1f, 0xc8, 0xcd, 0x56, 0x2e, 0x7d, 0xdb, 0x27,
-	0xb1, 0xb3, 0x0f, 0xef, 0x46, 0xec, 0x92, 0x67, 0x7f, 0x23, 0x76, 0xe9, 0x8b, 0xfd, 0x15, 0xe7,
-	0x35, 0xb4, 0x8b, 0x2f, 0xd9, 0x8e, 0x76, 0xd2, 0xc2, 0xa7, 0xfc, 0xde, 0x9d, 0x25, 0xb3, 0x46,
-	0xe0, 0x67, 0xb0, 0x22, 0x9f, 0xa8, 0x75, 0x3a, 0xda, 0x2f, 0xdb, 0xbd, 0x6b, 0x45, 0xa4, 0xe1,
-	0x7a, 0x04, 0x35, 0x79, 0xbb, 0x34, 0x01, 0x50, 0xb8, 0x6c, 0xf6, 0x9a, 0x36, 0xd6, 0xbd, 0xf2,
-	0xa8, 0xa4, 0xf5, 0x64, 0x05, 0x3d, 0xd9, 0x22, 0x3d, 0xd6, 0xe6, 0x1c, 0xd5, 0x28, 0x5d, 0x1f,
-	0xff, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x11, 0x58, 0x45, 0xd9, 0xb2, 0x1f, 0x00, 0x00,
+	// 2624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xed, 0x19, 0xcb, 0x8e, 0x1c, 0x49,
+	0xd1, 0xfd, 0x98, 0x9e, 0xe9, 0xe8, 0xc7, 0x4c, 0x97, 0xed, 0x71, 0xbb, 0xfd, 0xa4, 0xb4, 0x80,
+	0x01, 0x6b, 0x6c, 0xc6, 0xbb, 0x60, 0x81, 0x84, 0x64, 0xcf, 0x78, 0x97, 0x61, 0xfd, 0x18, 0xd7,
+	0x8c, 0xb1, 0x90, 0x90, 0x5a, 0x35, 0xdd, 0xe9, 0xee, 0x62, 0xaa, 0xab, 0x6a, 0xab, 0xb2, 0xe7,
+	0x71, 0xe1, 0xc0, 0x01, 0x6e, 0x70, 0x45, 0xe2, 0xc8, 0x8d, 0x3f, 0x80, 0x1f, 0xe0, 0x13, 0xf8,
+	0x03, 0x6e, 0xdc, 0xf7, 0x48, 0x64, 0xe4, 0xa3, 0xb2, 0xfa, 0x31, 0xb6, 0x91, 0x10, 0x17, 0x2e,
+	0xa5, 0x8c, 0xc8, 0x78, 0x65, 0x64, 0x44, 0x64, 0x64, 0x16, 0xd4, 0xfd, 0x24, 0xd8, 0x4a, 0xd2,
+	0x98, 0xc7, 0xce, 0x0a, 0x3f, 0x4f, 0x58, 0xd6, 0xbb, 0x33, 0x8a, 0xe3, 0x51, 0xc8, 0x1e, 0x10,
+	0xf2, 0x68, 0xfa, 0xee, 0x01, 0x0f, 0x26, 0x2c, 0xe3, 0xfe, 0x24, 0x91, 0x74, 0xee, 0x75, 0xb8,
+	0xf6, 0x05, 0xe3, 0x07, 0x2c, 0x3d, 0x61, 0xe9, 0xcf, 0x59, 0x9a, 0x05, 0x71, 0xe4, 0xb1, 0xaf,
+	0xa6, 0x48, 0xe3, 0x9e, 0x41, 0x77, 0x7e, 0x2a, 0x4b, 0xe2, 0x28, 0x63, 0xce, 0x15, 0x58, 0x99,
+	0xf8, 0xbf, 0x8a, 0xd3, 0x6e, 0xe9, 0x6e, 0xe9, 0x5e, 0xcb, 0x93, 0x00, 0x61, 0x83, 0x08, 0xb1,
+	0x65, 0x85, 0x15, 0x80, 0xc0, 0x26, 0x3e, 0x1f, 0x8c, 0xbb, 0x15, 0x89, 0x25, 0xc0, 0xe9, 0xc1,
+	0x5a, 0xca, 0x4e, 0x02, 0x21, 0xb5, 0x5b, 0xc5, 0x89, 0xba, 0x67, 0x60, 0xf7, 0xb7, 0x25, 0xb8,
+	0xf2, 0x26, 0x19, 0xfa, 0x9c, 0xed, 0xa7, 0xf1, 0x80, 0x65, 0x99, 0x32, 0xc9, 0x69, 0x43, 0x39,
+	0x18, 0x92, 0xce, 0xba, 0x87, 0x23, 0x67, 0x03, 0x2a, 0x09, 0x22, 0xca, 0x84, 0x10, 0x43, 0xe7,
+	0x36, 0xc0, 0x20, 0x8c, 0x33, 0x76, 0xc0, 0x87, 0x41, 0x44, 0x1a, 0xd7, 0x3c, 0x0b, 0x23, 0x8c,
+	0x39, 0x0d, 0x86, 0x7c, 0x4c, 0x3a, 0xd1, 0x18, 0x02, 0x9c, 0x4d, 0xa8, 0x8d, 0x59, 0x30, 0x1a,
+	0xf3, 0xee, 0x0a, 0xa1, 0x15, 0xe4, 0x5e, 0x83, 0xab, 0x33, 0x76, 0xc8, 0xf5, 0xbb, 0x5f, 0x97,
+	0x61, 0x73, 0x27, 0x65, 0x38, 0xb3, 0x13, 0x47, 0xdc, 0x0f, 0x22, 0x96, 0x2e, 0xb3, 0x11, 0x2d,
+	0x3a, 0x9a, 0x46, 0xc3, 0x90, 0xed, 0xfb, 0xa8, 0x56, 0x9a, 0x6a, 0x61, 0xc8, 0xe2, 0x31, 0x1b,
+	0x1c, 0x27, 0x71, 0x10, 0x71, 0xb2, 0x18, 0xe7, 0x73, 0x8c, 0xb0, 0x38, 0xa3, 0xc5, 0x48, 0x2f,
+	0x49, 0x40, 0x58, 0x8c, 0x83, 0x78, 0x2a, 0x2d, 0xae, 0x7b, 0x0a, 0x52, 0x78, 0x96, 0xa6, 0xdd,
+	0x9a, 0xc1, 0x23, 0x24, 0xf0, 0xa1, 0x7f, 0xc4, 0xc2, 0xac, 0xbb, 0x7a, 0xb7, 0x22, 0xf0, 0x12,
+	0x72, 0xee, 0x42, 0x23, 0x8a, 0xf7, 0x83, 0x93, 0x98, 0x7b, 0x71, 0xcc, 0xbb, 0x6b, 0xe4, 0x30,
+	0x1b, 0xe5, 0x74, 0x61, 0x35, 0x9d, 0x46, 0x22, 0x6e, 0xba, 0x75, 0x12, 0xa9, 0x41, 0xc1, 0xab,
+	0x86, 0x4f, 0xd2, 0x51, 0xd6, 0x05, 0x12, 0x6c, 0xa3, 0x9c, 0x4f, 0xa0, 0x95, 0xaf, 0x64, 0x37,
+	0x48, 0xbb, 0x0d, 0x92, 0x50, 0x44, 0x3a, 0xf7, 0xa1, 0x33, 0x64, 0x9c, 0x0d, 0xf8, 0x4b, 0xcb,
+	0x92, 0x26, 0x59, 0x32, 0x3f, 0xe1, 0xee, 0xc1, 0xb5, 0x39, 0xcf, 0xab, 0xa8, 0xdc, 0x82, 0xfa,
+	0x40, 0x23, 0x69, 0x07, 0x1a, 0xdb, 0x1b, 0x5b, 0x94, 0x08, 0x5b, 0x39, 0x71, 0x4e, 0x82, 0xa2,
+	0x5a, 0x07, 0xc1, 0x28, 0xf2, 0xc3, 0x0f, 0x8f, 0x2f, 0xe1, 0x5f, 0x62, 0x51, 0xd1, 0xac, 0x20,
+	0x77, 0x03, 0xda, 0x5a, 0x94, 0x0a, 0x91, 0x7f, 0x54, 0xa0, 0xf3, 0x64, 0x38, 0x7c, 0x4f, 0x04,
+	0x63, 0x1a, 0x70, 0x96, 0x62, 0xa2, 0xa0, 0xc4, 0x32, 0x2d, 0xd9, 0xc0, 0xce, 0x1d, 0xa8, 0x4e,
+	0x33, 0x5c, 0x49, 0x85, 0x56, 0xd2, 0x50, 0x2b, 0x79, 0x83, 0x28, 0x8f, 0x26, 0x1c, 0x07, 0xaa,
+	0xbe, 0xf0, 0x7c, 0x95, 0x3c, 0x4f, 0x63, 0x61, 0x32, 0x8b, 0x4e, 0x30, 0x2a, 0x04, 0x4a, 0x0c,
+	0x05, 0x66, 0x70, 0x3a, 0x54, 0xf1, 0x20, 0x86, 0x7a, 0x59, 0xab, 0xf9, 0xb2, 0x4c, 0x90, 0xad,
+	0x2d, 0x0e, 0xb2, 0xfa, 0x92, 0x20, 0x83, 0x42, 0x90, 0xb9, 0xd0, 0x1c, 0xf8, 0x89, 0x7f, 0x14,
+	0x84, 0x01, 0x0f, 0x58, 0x86, 0xbb, 0x2d, 0x8c, 0x28, 0xe0, 0x9c, 0x7b, 0xb0, 0xee, 0x27, 0x89,
+	0x9f, 0x4e, 0xe2, 0x14, 0x5d, 0xf3, 0x2e, 0x08, 0x19, 0x6d, 0x75, 0xdd, 0x9b, 0x45, 0x0b, 0x69,
+	0x19, 0x0b, 0x83, 0x68, 0x7a, 0xf6, 0x5c, 0xc4, 0x6a, 0xb7, 0x45, 0x64, 0x05, 0x9c, 0x90, 0x16,
+	0xc5, 0x2f, 0xd9, 0xe9, 0x7e, 0x1a, 0x9c, 0x20, 0xcf, 0x08, 0x95, 0xb6, 0xc9, 0x8b, 0xb3, 0x68,
+	0xe7, 0xdb, 0x18, 0xc6, 0x61, 0x30, 0x09, 0x78, 0xd6, 0x5d, 0x47, 0xb3, 0x1a, 0xdb, 0x2d, 0xe5,
+	0x4f, 0x8f, 0xb0, 0x9e, 0x9e, 0x9d, 0x8d, 0xea, 0x8d, 0xb9, 0xa8, 0x76, 0x77, 0xa1, 0x26, 0x99,
+	0xc4, 0x06, 0x08, 0x21, 0x6a, 0x3f, 0x69, 0x2c, 0x70, 0x59, 0xfc, 0x8e, 0xd3, 0x6e, 0x56, 0x3d,
+	0x1a, 0x0b, 0xdc, 0xd8, 0x4f, 0x87, 0xb4, 0x93, 0x88, 0x13, 0x63, 0xd7, 0x83, 0xaa, 0xd8, 0x4a,
+	0xb1, 0x19, 0x53, 0x15, 0x12, 0x2d, 0x4f, 0x0c, 0x05, 0x66, 0xa4, 0xa2, 0x0e, 0x31, 0x38, 0x74,
+	0xbe, 0x05, 0x6d, 0x7f, 0x38, 0x44, 0x07, 0xc6, 0x18, 0x17, 0x5f, 0x04, 0xc3, 0x0c, 0x25, 0x55,
+	0x70, 0x72, 0x06, 0xeb, 0x6e, 0x83, 0x63, 0x87, 0x9c, 0x4a, 0x8b, 0x9b, 0x50, 0xcf, 0xce, 0x33,
+	0xce, 0x26, 0xfb, 0x46, 0x4f, 0x8e, 0x70, 0x7f, 0x53, 0x32, 0x09, 0x65, 0xb2, 0x72, 0x59, 0xb4,
+	0x7e, 0xbf, 0x50, 0xab, 0xca, 0x14, 0x97, 0x1d, 0x9d, 0x61, 0x39, 0xb7, 0x5d, 0xbe, 0xe6, 0x4a,
+	0x40, 0x65, 0x41, 0x09, 0x70, 0x7b, 0xd0, 0x9d, 0xb7, 0x41, 0x25, 0xd2, 0x00, 0xae, 0xed, 0xb2,
+	0x90, 0x7d, 0x88, 0x7d, 0xe8, 0xe7, 0xc8, 0xc7, 0x42, 0x25, 0x13, 0x96, 0xc6, 0x1f, 0x6e, 0xc0,
+	0xbc, 0x12, 0x65, 0xc0, 0x0b, 0xb8, 0xfa, 0x3c, 0xc8, 0xf8, 0xfb, 0xd5, 0xcf, 0xa9, 0x2a, 0x2f,
+	0x52, 0xf5, 0xc7, 0x12, 0x40, 0x2e, 0xcb, 0xd8, 0x5c, 0xb2, 0x6c, 0x46, 0x1c, 0x3b, 0x0b, 0xb8,
+	0xaa, 0x08, 0x34, 0x16, 0x51, 0xc1, 0x07, 0x89, 0x3a, 0xd2, 0xc4, 0x50, 0x44, 0xea, 0x34, 0x0a,
+	0xce, 0x0e, 0xe2, 0xc1, 0x31, 0xe3, 0x19, 0x9d, 0x0f, 0x58, 0xbb, 0x2d, 0x14, 0xa5, 0xf5, 0x98,
+	0x85, 0x21, 0x1d, 0x12, 0x6b, 0x9e, 0x04, 0x44, 0x45, 0x67, 0x93, 0x84, 0x9f, 0xbf, 0x3c, 0xc0,
+	0xa2, 0x20, 0xa2, 0x5b, 0x83, 0xb8, 0xd2, 0xcd, 0xd9, 0x95, 0xaa, 0x18, 0x7a, 0x04, 0x8d, 0x7c,
+	0x15, 0x19, 0x1a, 0x5b, 0x59, 0xbc, 0xf5, 0x36, 0x95, 0x7b, 0x1b, 0x9a, 0x07, 0x1c, 0x37, 0x75,
+	0x89, 0xbf, 0xdc, 0x7b, 0xd0, 0x36, 0x75, 0x99, 0x08, 0x65, 0x65, 0xf1, 0xf9, 0x34, 0x53, 0x54,
+	0x0a, 0x72, 0xff, 0x5a, 0x81, 0x55, 0x15, 0xd6, 0xba, 0x7a, 0x95, 0xf2, 0xea, 0xf5, 0x3f, 0x29,
+	0xa2, 0x85, 0xac, 0x5a, 0x9d, 0xc9, 0xaa, 0xff, 0x17, 0x54, 0x53, 0x50, 0xdd, 0xbf, 0x97, 0xa0,
+	0x6e, 0xb6, 0xf9, 0xa3, 0xdb, 0xa3, 0xfb, 0x50, 0x4f, 0xe4, 0xc6, 0x33, 0x59, 0xf5, 0x1a, 0xdb,
+	0x6d, 0xa5, 0x48, 0xd7, 0xb9, 0x9c, 0xc0, 0x8a, 0x9f, 0xaa, 0x1d, 0x3f, 0x56, 0xfb, 0xb3, 0x52,
+	0x68, 0x7f, 0x70, 0xf3, 0x13, 0x51, 0x4e, 0x6b, 0x54, 0x4e, 0x69, 0x6c, 0x37, 0x3c, 0xab, 0x85,
+	0x86, 0xc7, 0xfd, 0x0c, 0x56, 0x5f, 0xf8, 0x83, 0x31, 0xae, 0x43, 0x30, 0x0e, 0x12, 0x15, 0xa6,
+	0xc8, 0x28, 0xc6, 0x42, 0xc9, 0x84, 0xa1, 0xbf, 0xcf, 0x55, 0xed, 0x57, 0x90, 0x7b, 0x8c, 0x6d,
+	0x86, 0x4c, 0x03, 0x95, 0x4c, 0x0f, 0xb1, 0x8c, 0x6a, 0x87, 0xe8, 0x5c, 0x9a, 0x6f, 0x54, 0x2c,
+	0x1a, 0xdc, 0x96, 0xd5, 0x89, 0xd4, 0xac, 0xaa, 0xae, 0xf6, 0x81, 0xb2, 0xc7, 0xd3, 0xd3, 0xee,
+	0xef, 0x4a, 0xb0, 0x29, 0x7b, 0xd6, 0xf7, 0x76, 0xa6, 0x8b, 0xbb, 0x1b, 0xe9, 0xbe, 0x4a, 0xc1,
+	0x7d, 0x8f, 0xa0, 0x9e, 0xb2, 0x2c, 0x9e, 0xa6, 0xe8, 0x66, 0xf2, 0x6c, 0x63, 0xfb, 0xaa, 0xce,
+	0x24, 0xd2, 0xe5, 0xa9, 0x59, 0x2f, 0xa7, 0x73, 0xff, 0x55, 0x83, 0x76, 0x71, 0x56, 0x54, 0xac,
+	0xa3, 0xf0, 0x38, 0x88, 0xdf, 0xca, 0x66, 0xbb, 0x44, 0x6e, 0xb2, 0x51, 0x22, 0xab, 0xd0, 0x97,
+	0x07, 0x78, 0x42, 0xa2, 0x26, 0xe9, 0xc6, 0x1c, 0xa1, 0x66, 0xf7, 0x59, 0x1a, 0xc4, 0xfa, 0x30,
+	0xcd, 0x11, 0xa2, 0x0c, 0x20, 0xf0, 0x7a, 0x1a, 0x73, 0x9f, 0x8c, 0xac, 0x7a, 0x06, 0xa6, 0x2e,
+	0x1b, 0xf7, 0x88, 0xf1, 0x1d, 0xb1, 0x6b, 0x2b, 0xaa, 0xcb, 0x36, 0x98, 0x7c, 0xfe, 0x05, 0x9b,
+	0x64, 0x2a, 0xcd, 0x2d, 0x8c, 0xb0, 0x5c, 0xee, 0xe6, 0x73, 0x11, 0xd4, 0x14, 0x18, 0x68, 0xb9,
+	0x85, 0x12, 0x12, 0x24, 0x78, 0x70, 0xea, 0x27, 0x94, 0xf6, 0x55, 0xcf, 0xc2, 0x88, 0x2e, 0x57,
+	0x42, 0xe8, 0x0d, 0xbc, 0x53, 0xf9, 0xe2, 0xd8, 0xa6, 0x32, 0x50, 0xf5, 0xe6, 0x27, 0x04, 0xf5,
+	0x31, 0x4b, 0x23, 0x16, 0xbe, 0xb0, 0xb4, 0x82, 0xa4, 0x9e, 0x9b, 0x70, 0xb6, 0xe1, 0x8a, 0x44,
+	0x1e, 0xee, 0xec, 0xdb, 0x0c, 0x0d, 0x62, 0x58, 0x38, 0x27, 0x32, 0x9d, 0x1c, 0xff, 0x9c, 0xf9,
+	0xef, 0xd4, 0x7e, 0x34, 0x89, 0x7c, 0x16, 0xed, 0x3c, 0x81, 0x8e, 0xb5, 0x45, 0xbb, 0x78, 0x4b,
+	0x1b, 0x30, 0x2c, 0x1e, 0x22, 0x6a, 0x2f, 0xab, 0x28, 0xb0, 0xa7, 0xbc, 0x79, 0x6a, 0xe7, 0x0d,
+	0xf4, 0x08, 0x79, 0x38, 0xc6, 0x5b, 0x27, 0x0f, 0x31, 0x22, 0xfc, 0xe1, 0xd3, 0x24, 0x53, 0xb2,
+	0xda, 0x24, 0x4b, 0x47, 0x94, 0xa6, 0x51, 0xd2, 0x2e, 0x60, 0x74, 0xde, 0xc2, 0x8d, 0xc2, 0xec,
+	0xdb, 0x34, 0xe0, 0x2c, 0x97, 0xbb, 0x7e, 0x91, 0xdc, 0x8b, 0x38, 0xe7, 0x04, 0x0b, 0xb5, 0x7b,
+	0xb1, 0x11, 0xbc, 0xf1, 0xe1, 0x82, 0x8b, 0x9c, 0xce, 0x2f, 0xe0, 0xe6, 0xbc, 0x5e, 0x4b, 0x72,
+	0xe7, 0x22, 0xc9, 0x17, 0xb2, 0xba, 0x3f, 0x86, 0xd6, 0xd3, 0x10, 0x0f, 0xfe, 0xbd, 0x57, 0x4a,
+	0x57, 0xe1, 0x92, 0x5e, 0x59, 0x78, 0x49, 0xaf, 0xa8, 0x4b, 0xba, 0xfb, 0x6b, 0x68, 0x16, 0x36,
+	0xec, 0x07, 0x94, 0xa9, 0x5a, 0x94, 0xba, 0x4c, 0x5d, 0x51, 0x66, 0x15, 0xd4, 0x78, 0x36, 0xa1,
+	0xa8, 0x20, 0xa7, 0x32, 0x98, 0x64, 0xfb, 0xaa, 0x20, 0x91, 0x1d, 0x61, 0x1e, 0x68, 0xf2, 0xee,
+	0x64, 0x61, 0xdc, 0x5f, 0x42, 0xbb, 0xb8, 0xd8, 0xff, 0xd8, 0x02, 0xac, 0xcc, 0x29, 0xd6, 0x1c,
+	0xdd, 0x7f, 0x8b, 0xb1, 0x78, 0xe5, 0x98, 0xab, 0x89, 0xaa, 0xb9, 0x3b, 0x87, 0xd6, 0xb3, 0x13,
+	0x86, 0xdd, 0x8a, 0xae, 0x92, 0x8f, 0xa1, 0x6e, 0x1e, 0x49, 0x54, 0xb1, 0xed, 0x6d, 0xc9, 0x67,
+	0x94, 0x2d, 0xfd, 0x8c, 0xb2, 0x75, 0xa8, 0x29, 0xbc, 0x9c, 0x58, 0xac, 0x31, 0xe3, 0x71, 0xca,
+	0x86, 0xaf, 0xa2, 0xf0, 0x5c, 0xbf, 0x3d, 0xe4, 0x18, 0x55, 0x7f, 0xab, 0xa6, 0xfd, 0xf9, 0x43,
+	0x09, 0x56, 0x48, 0xf7, 0xc2, 0x7b, 0x84, 0xa4, 0x2e, 0x9b, 0x6a, 0x5d, 0xac, 0xcd, 0x2d, 0x53,
+	0x9b, 0x55, 0x15, 0xaf, 0xe6, 0x55, 0xbc, 0xb0, 0x82, 0xda, 0x47, 0xac, 0xc0, 0xfd, 0x7d, 0x19,
+	0x9a, 0x2f, 0x19, 0x3f, 0x8d, 0xd3, 0x63, 0x71, 0x62, 0x65, 0x0b, 0x9b, 0xd3, 0xeb, 0xb0, 0x96,
+	0x9e, 0xf5, 0x8f, 0xce, 0xb9, 0xa9, 0xd0, 0xab, 0xe9, 0xd9, 0x53, 0x01, 0x3a, 0xb7, 0x00, 0x70,
+	0x6a, 0xdf, 0x97, 0x0d, 0xa9, 0x2a, 0xd0, 0xe9, 0x99, 0x42, 0x38, 0x37, 0xa0, 0xee, 0x9d, 0xf5,
+	0xb1, 0xb1, 0x89, 0xd3, 0x4c, 0x57, 0xe8, 0xf4, 0xec, 0x19, 0xc1, 0x82, 0x17, 0x27, 0x87, 0x69,
+	0x9c, 0x24, 0x6c, 0x48, 0x15, 0x9a, 0x78, 0x77, 0x25, 0x42, 0x68, 0x3d, 0xd4, 0x5a, 0x6b, 0x52,
+	0x2b, 0xcf, 0xb5, 0xe2, 0x54, 0xa2, 0xb4, 0xca, 0xd2, 0x5c, 0xe7, 0xb6, 0xd6, 0x43, 0xa3, 0x55,
+	0xd6, 0xe5, 0x35, 0x6e, 0x69, 0x3d, 0xcc, 0xb5, 0xd6, 0x35, 0xaf, 0xd2, 0xea, 0xfe, 0xa5, 0x04,
+	0x6b, 0x78, 0x3e, 0xbc, 0xc9, 0xfc, 0x11, 0xc3, 0x56, 0xb2, 0xc1, 0xf1, 0x2c, 0x09, 0xfb, 0x53,
+	0x01, 0xaa, 0xd3, 0x0b, 0x08, 0x25, 0x09, 0xbe, 0x01, 0xcd, 0x84, 0xa5, 0x78, 0x6a, 0x28, 0x8a,
+	0x32, 0x26, 0x33, 0x9e, 0x12, 0x12, 0x27, 0x49, 0xb6, 0xe0, 0x32, 0xcd, 0xf5, 0x83, 0xa8, 0x2f,
+	0xcb, 0xf2, 0x24, 0x1e, 0x32, 0xe5, 0xaa, 0x0e, 0x4d, 0xed, 0x45, 0x5f, 0x9a, 0x09, 0xe7, 0xbb,
+	0xd0, 0x31, 0xf4, 0xa2, 0x5d, 0x25, 0x6a, 0xe9, 0xba, 0x75, 0x45, 0xfd, 0x46, 0xa1, 0x31, 0x87,
+	0x75, 0x0e, 0x05, 0xd1, 0x68, 0xd7, 0xc7, 0x53, 0x0f, 0x5b, 0x99, 0x84, 0xce, 0xc6, 0x4c, 0x59,
+	0xab, 0x41, 0xe7, 0x7b, 0xd0, 0xe1, 0x2a, 0xdf, 0x86, 0x7d, 0x4d, 0x23, 0x77, 0x73, 0xc3, 0x4c,
+	0xec, 0x2b, 0xe2, 0x6f, 0x42, 0x3b, 0x27, 0xa6, 0xc6, 0x48, 0xda, 0xdb, 0x32, 0x58, 0x11, 0x4d,
+	0xee, 0x9f, 0xa4, 0xb3, 0x64, 0xe4, 0xdc, 0xa7, 0xa3, 0xda, 0x72, 0x55, 0x63, 0x7b, 0x5d, 0xb7,
+	0x38, 0xca, 0x19, 0x74, 0x3c, 0x4b, 0xb7, 0xfc, 0x04, 0xd6, 0xb9, 0x31, 0xbd, 0x8f, 0x99, 0xea,
+	0xab, 0xd4, 0x9b, 0xa9, 0x84, 0x6a, 0x61, 0x5e, 0x9b, 0x17, 0x17, 0x8a, 0x9e, 0x97, 0xbd, 0xb7,
+	0x52, 0x28, 0xed, 0x6b, 0x48, 0x1c, 0xa9, 0xc0, 0xf2, 0x58, 0xc7, 0xc6, 0x3c, 0x93, 0xd6, 0xa1,
+	0x63, 0x06, 0xd3, 0x34, 0xc5, 0xdc, 0xd3, 0x8e, 0x51, 0xa0, 0x28, 0x8f, 0xd4, 0xb7, 0x2a, 0x67,
+	0x48, 0xc0, 0x8d, 0x01, 0xe4, 0xd9, 0x49, 0xda, 0x90, 0xc6, 0x0e, 0x01, 0x09, 0x88, 0x38, 0x9b,
+	0xf8, 0x67, 0x66, 0xeb, 0x29, 0xce, 0x10, 0x21, 0x17, 0x88, 0x0a, 0xdf, 0xf9, 0x41, 0x38, 0x50,
+	0x4f, 0x7c, 0xa8, 0x50, 0x81, 0xb9, 0xc2, 0xaa, 0xad, 0xf0, 0xcf, 0x65, 0x68, 0x48, 0x8d, 0xd2,
+	0x60, 0xa4, 0x1a, 0x60, 0x87, 0x67, 0x54, 0x12, 0x80, 0x3d, 0xf8, 0x4a, 0xae, 0x2e, 0xbf, 0x8f,
+	0xe5, 0xa6, 0x6a, 0xdb, 0xb0, 0xe3, 0xcc, 0xb0, 0x09, 0xb1, 0xbc, 0xb3, 0x90, 0xba, 0x2e, 0x88,
+	0xa4, 0xc1, 0x9f, 0x42, 0x53, 0xc6, 0xa7, 0xe2, 0xa9, 0x2e, 0xe3, 0x69, 0x48, 0x32, 0xc9, 0xf5,
+	0x48, 0x5c, 0x7b, 0xd0, 0x5e, 0x6a, 0xb3, 0x1b, 0xdb, 0xb7, 0x0a, 0xe4, 0xb4, 0x92, 0x2d, 0xfa,
+	0x3e, 0x8b, 0x38, 0xf6, 0x3b, 0x92, 0xb6, 0xf7, 0x18, 0x20, 0x47, 0x8a, 0x7a, 0x76, 0xcc, 0xce,
+	0xf5, 0xf5, 0x0e, 0x87, 0x62, 0xed, 0x27, 0x7e, 0x38, 0xd5, 0x4e, 0x95, 0xc0, 0x8f, 0xca, 0x8f,
+	0x4b, 0xee, 0x00, 0xd6, 0x9f, 0x8a, 0x23, 0xd1, 0x62, 0x2f, 0x1c, 0x7a, 0xd5, 0x85, 0x87, 0x5e,
+	0x55, 0xbf, 0x4c, 0x63, 0x89, 0x8d, 0x13, 0xd5, 0xea, 0xe2, 0x28, 0x57, 0x54, 0xb5, 0x14, 0xb9,
+	0xff, 0xac, 0x02, 0xe4, 0x5a, 0x9c, 0x03, 0xe8, 0x05, 0x71, 0x5f, 0x74, 0x6a, 0x78, 0xda, 0xc8,
+	0x82, 0xd4, 0x4f, 0x19, 0x86, 0x4f, 0x16, 0x9c, 0x30, 0xd5, 0xcc, 0x6f, 0x9a, 0x63, 0xaa, 0x60,
+	0x9c, 0x77, 0x0d, 0x21, 0xc9, 0x48, 0x95, 0xcb, 0xd3, 0x6c, 0xce, 0xcf, 0xe0, 0x6a, 0x2e, 0x74,
+	0x68, 0xc9, 0x2b, 0x5f, 0x28, 0xef, 0xb2, 0x91, 0x37, 0xcc, 0x65, 0x7d, 0x0e, 0x88, 0xee, 0xe3,
+	0x61, 0x36, 0x2d, 0x48, 0xaa, 0x5c, 0x28, 0xa9, 0x13, 0xc4, 0xaf, 0x89, 0x23, 0x97, 0xf3, 0x1a,
+	0xae, 0x5b, 0x0b, 0x15, 0x69, 0x6f, 0x49, 0xab, 0x5e, 0x28, 0x6d, 0xd3, 0xd8, 0x25, 0x0a, 0x43,
+	0x2e, 0xf2, 0x4b, 0xc0, 0x99, 0xfe, 0xa9, 0x1f, 0xf0, 0x59, 0x79, 0x2b, 0xef, 0x5b, 0xe7, 0x5b,
+	0x64, 0x2a, 0x0a, 0x93, 0xeb, 0x9c, 0xb0, 0x74, 0x54, 0x58, 0x67, 0xed, 0x7d, 0xeb, 0x7c, 0x41,
+	0x1c, 0xb9, 0x9c, 0xa7, 0x80, 0xc8, 0x59, 0x7b, 0x56, 0x2f, 0x94, 0xb2, 0x8e, 0x5d, 0x58, 0xc1,
+	0x96, 0x1d, 0xe8, 0x64, 0x6c, 0x80, 0x47, 0xbd, 0x1d, 0x0b, 0x6b, 0x17, 0xca, 0xd8, 0x50, 0x0c,
+	0x46, 0x88, 0xfb, 0x15, 0x34, 0x7f, 0x3a, 0x1d, 0x31, 0x1e, 0x1e, 0x99, 0x9c, 0xff, 0x6f, 0x97,
+	0x99, 0xaf, 0xb1, 0xcc, 0xec, 0x8c, 0xd2, 0x78, 0x9a, 0x14, 0xaa, 0xb6, 0xcc, 0xe1, 0xb9, 0xaa,
+	0x4d, 0x34, 0x54, 0xb5, 0x25, 0xf5, 0x67, 0xd0, 0x94, 0x37, 0x17, 0xc5, 0x20, 0xab, 0x90, 0x33,
+	0x9f, 0xf4, 0xfa, 0xa6, 0x24, 0xd9, 0xb6, 0xd5, 0x2d, 0x50, 0x71, 0x15, 0xab, 0x51, 0xee, 0x26,
+	0x0f, 0x8e, 0xf2, 0xac, 0xdb, 0x83, 0xd6, 0x58, 0xfa, 0x46, 0x71, 0xc9, 0x00, 0xfc, 0x44, 0x1b,
+	0x97, 0xaf, 0x61, 0xcb, 0xf6, 0xa1, 0x74, 0x75, 0x73, 0x6c, 0xbb, 0xf5, 0x01, 0x80, 0xb8, 0xe7,
+	0xf7, 0x75, 0xa1, 0xb2, 0x7f, 0x13, 0x98, 0x13, 0xc2, 0xab, 0x27, 0x7a, 0xd8, 0x3b, 0x84, 0xce,
+	0x9c, 0xcc, 0x05, 0x65, 0xea, 0x3b, 0x76, 0x99, 0xca, 0xaf, 0x46, 0x36, 0xab, 0x5d, 0xbb, 0xfe,
+	0x56, 0x92, 0xcf, 0x02, 0xf9, 0x3b, 0xed, 0x63, 0x68, 0x45, 0xb2, 0xf9, 0x32, 0x1b, 0x60, 0xdf,
+	0xb1, 0xec, 0xc6, 0xcc, 0x6b, 0x46, 0x76, 0x9b, 0x86, 0x1b, 0x31, 0x20, 0x0f, 0x2c, 0xdc, 0x08,
+	0xcb, 0x39, 0x5e, 0x63, 0x60, 0xed, 0x76, 0xa1, 0x51, 0xac, 0x7e, 0x4c, 0xa3, 0xa8, 0x5e, 0xf6,
+	0x96, 0xfd, 0xd6, 0xd8, 0xc6, 0xbb, 0x7f, 0xe5, 0xc9, 0xfe, 0x1e, 0xde, 0xfb, 0x36, 0x66, 0xff,
+	0x21, 0x3a, 0xb7, 0x95, 0x59, 0x4b, 0xfe, 0x3b, 0xf6, 0xee, 0x2c, 0x9d, 0x57, 0x2d, 0xfb, 0x25,
+	0xc7, 0x83, 0xf5, 0x99, 0x7f, 0x40, 0x8e, 0x3e, 0x6a, 0x16, 0xff, 0x95, 0xeb, 0xdd, 0x5e, 0x36,
+	0x6d, 0xcb, 0x9c, 0xb9, 0x23, 0x18, 0x99, 0x8b, 0xdf, 0x53, 0x8c, 0xcc, 0x65, 0x57, 0x8b, 0x4b,
+	0xce, 0x0f, 0xa1, 0x26, 0xff, 0x0a, 0x39, 0xfa, 0xe2, 0x52, 0xf8, 0xdf, 0xd4, 0xbb, 0x3a, 0x83,
+	0x35, 0x8c, 0xcf, 0xa1, 0x55, 0xf8, 0xf1, 0xe8, 0xdc, 0x28, 0xe8, 0x2a, 0xfe, 0x54, 0xea, 0xdd,
+	0x5c, 0x3c, 0x69, 0xa4, 0xed, 0x00, 0xe4, 0xbf, 0x05, 0x9c, 0xae, 0xa2, 0x9e, 0xfb, 0x39, 0xd5,
+	0xbb, 0xbe, 0x60, 0xc6, 0x08, 0xc1, 0xad, 0x9c, 0x7d, 0xa2, 0x77, 0x66, 0xbc, 0x3a, 0xfb, 0x40,
+	0x6e, 0xb6, 0x72, 0xe9, 0xdb, 0x3e, 0x89, 0x9d, 0x7d, 0x78, 0x37, 0x62, 0x97, 0x3c, 0xfb, 0x1b,
+	0xb1, 0x4b, 0x5f, 0xec, 0x2f, 0x39, 0xaf, 0xa0, 0x5d, 0x7c, 0xc9, 0x76, 0xb4, 0x93, 0x16, 0x3e,
+	0xe5, 0xf7, 0x6e, 0x2d, 0x99, 0x35, 0x02, 0x3f, 0x85, 0x15, 0xf9, 0x44, 0xad, 0xd3, 0xd1, 0x7e,
+	0xd9, 0xee, 0x5d, 0x29, 0x22, 0x0d, 0xd7, 0x43, 0xa8, 0xc9, 0xdb, 0xa5, 0x09, 0x80, 0xc2, 0x65,
+	0xb3, 0xd7, 0xb4, 0xb1, 0xee, 0xa5, 0x87, 0x25, 0xad, 0x27, 0x2b, 0xe8, 0xc9, 0x16, 0xe9, 0xb1,
+	0x36, 0xe7, 0xa8, 0x46, 0xe9, 0xfa, 0xe8, 0xdf, 0xda, 0x81, 0xca, 0x85, 0x02, 0x20, 0x00, 0x00,
 }
@@ -26,8 +26,9 @@ type Container interface {
 	Path() string
 	// Start starts the init process of the container
 	Start(ctx context.Context, checkpointPath string, s Stdio) (Process, error)
-	// Exec starts another process in an existing container
-	Exec(context.Context, string, specs.ProcessSpec, Stdio) (Process, error)
+	// Exec starts another process in an existing container. The process is
+	// run with the given runtime args, or the container's if there are none.
+	Exec(context.Context, string, specs.ProcessSpec, []string, Stdio) (Process, error)
 	// Delete removes the container's state and any resources
 	Delete() error
 	// Processes returns all the containers processes that have been added
@@ -434,7 +435,7 @@ func (c *container) Start(ctx context.Context, checkpointPath string, s Stdio) (
 	return p, nil
 }
 
-func (c *container) Exec(ctx context.Context, pid string, pspec specs.ProcessSpec, s Stdio) (pp Process, err error) {
+func (c *container) Exec(ctx context.Context, pid string, pspec specs.ProcessSpec, runtimeArgs []string, s Stdio) (pp Process, err error) {
 	processRoot := filepath.Join(c.root, c.id, pid)
 	if err := os.Mkdir(processRoot, 0755); err != nil {
 		return nil, err
@@ -463,6 +464,7 @@ func (c *container) Exec(ctx context.Context, pid string, pspec specs.ProcessSpe
 		processSpec: pspec,
 		spec:        spec,
 		stdio:       s,
+		runtimeArgs: runtimeArgs,
 	}
 	p, err := newProcess(config)
 	if err != nil {
@@ -62,6 +62,8 @@ type processConfig struct {
 	stdio       Stdio
 	exec        bool
 	checkpoint  string
+	// runtimeArgs override the runtime args of the container if not empty.
+	runtimeArgs []string
 }
 
 func newProcess(config *processConfig) (*process, error) {
@@ -98,6 +100,9 @@ func newProcess(config *processConfig) (*process, error) {
 		RuntimeArgs: config.c.runtimeArgs,
 		NoPivotRoot: config.c.noPivotRoot,
 	}
+	if len(config.runtimeArgs) > 0 {
+		ps.RuntimeArgs = config.runtimeArgs
+	}
 
 	if err := json.NewEncoder(f).Encode(ps); err != nil {
 		return nil, err
@@ -0,0 +1,54 @@
+package runtime
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestNewProcessRuntimeArgs(t *testing.T) {
+	c := &container{id: "c1", runtimeArgs: []string{"--debug"}}
+	for _, tc := range []struct {
+		runtimeArgs []string
+		expected    []string
+	}{
+		{nil, []string{"--debug"}},
+		{[]string{"--systemd-cgroup"}, []string{"--systemd-cgroup"}},
+	} {
+		root, err := ioutil.TempDir("", "containerd-process-test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(root)
+
+		p, err := newProcess(&processConfig{
+			id:          "exec1",
+			root:        root,
+			c:           c,
+			exec:        true,
+			runtimeArgs: tc.runtimeArgs,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.exitPipe.Close()
+		p.controlPipe.Close()
+
+		f, err := os.Open(filepath.Join(root, "process.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ps ProcessState
+		err = json.NewDecoder(f).Decode(&ps)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(ps.RuntimeArgs, tc.expected) {
+			t.Fatalf("expected runtime args %v for %v, got %v", tc.expected, tc.runtimeArgs, ps.RuntimeArgs)
+		}
+	}
+}
@@ -1,18 +1,18 @@
 package supervisor
 
 import (
+	"fmt"
+	"path/filepath"
 	"time"
 
-    "log"
-    "os"
-
 	"github.com/docker/containerd/runtime"
 	"github.com/docker/containerd/specs"
 	"golang.org/x/net/context"
 )
 
 // AddProcessTask holds everything necessary to add a process to a
-// container
+// container. The process is run with RuntimeArgs, or with the runtime args
+// of the container if it is empty.
 type AddProcessTask struct {
 	baseTask
 	ID            string
@@ -21,18 +21,27 @@ type AddProcessTask struct {
 	Stderr        string
 	Stdin         string
 	ProcessSpec   *specs.ProcessSpec
+	RuntimeArgs   []string
 	StartResponse chan StartResponse
 	Ctx           context.Context
 }
 
-func (s *Supervisor) addProcess(t *AddProcessTask) error {
+func (s *Supervisor) addProcess(t *AddProcessTask) (err error) {
+	defer func() {
+		if err != nil {
+			sendStartError(t.StartResponse, err)
+		}
+	}()
+	defer func() {
+		err = newTaskError("addProcess", t.ID, err)
+	}()
 	start := time.Now()
 	ci, ok := s.containers[t.ID]
 	if !ok {
-        logPrintAddPro("ErrContainerNotFound")
+		logPrintAddPro(fmt.Sprintf("addProcess id=%s pid=%s error=%v", t.ID, t.PID, ErrContainerNotFound))
 		return ErrContainerNotFound
 	}
-	process, err := ci.container.Exec(t.Ctx, t.PID, *t.ProcessSpec, runtime.NewStdio(t.Stdin, t.Stdout, t.Stderr))
+	process, err := ci.container.Exec(t.Ctx, t.PID, *t.ProcessSpec, t.RuntimeArgs, runtime.NewStdio(t.Stdin, t.Stdout, t.Stderr))
 	if err != nil {
 		return err
 	}
@@ -53,12 +62,5 @@ func (s *Supervisor) addProcess(t *AddProcessTask) error {
 
 
 func logPrintAddPro(errStr string) {
-    logFile, logError := os.Open("/home/vagrant/addlogServer.md")
-    if logError != nil {
-        logFile, _ = os.Create("/home/vagrant/addlogServer.md")
-    }
-    defer logFile.Close()
-
-    debugLog := log.New(logFile, "[Debug]", log.Llongfile)
-    debugLog.Println(errStr)
+	logPrintTo(filepath.Join(debugLogDir, "addlogServer.md"), errStr)
 }
@@ -0,0 +1,89 @@
+package supervisor
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/docker/containerd/specs"
+)
+
+func TestAddProcessExecErrorUnblocksStartResponse(t *testing.T) {
+	execErr := errors.New("exec failed")
+	s := newTestSupervisor(&fakeContainer{id: "c1", execErr: execErr})
+
+	task := &AddProcessTask{
+		ID:            "c1",
+		PID:           "exec1",
+		ProcessSpec:   &specs.ProcessSpec{},
+		StartResponse: make(chan StartResponse, 1),
+	}
+	if err := s.addProcess(task); taskErrorCause(err) != execErr {
+		t.Fatalf("expected %v, got %v", execErr, err)
+	}
+	select {
+	case r := <-task.StartResponse:
+		if taskErrorCause(r.Err) != execErr {
+			t.Fatalf("expected the start response to carry %v, got %v", execErr, r.Err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("waiting for the start response blocked")
+	}
+	if _, ok := <-task.StartResponse; ok {
+		t.Fatal("expected the start response channel to be closed")
+	}
+}
+
+func TestAddProcessNotFoundUnblocksStartResponse(t *testing.T) {
+	s := newTestSupervisor()
+
+	task := &AddProcessTask{ID: "missing", StartResponse: make(chan StartResponse)}
+	received := make(chan struct{})
+	go func() {
+		<-task.StartResponse
+		close(received)
+	}()
+	if err := s.addProcess(task); taskErrorCause(err) != ErrContainerNotFound {
+		t.Fatalf("expected %v, got %v", ErrContainerNotFound, err)
+	}
+	select {
+	case <-received:
+	case <-time.After(10 * time.Second):
+		t.Fatal("waiting for the start response blocked")
+	}
+}
+
+func TestAddProcessTaskError(t *testing.T) {
+	s := newTestSupervisor()
+
+	err := s.addProcess(&AddProcessTask{ID: "missing", PID: "exec1", StartResponse: make(chan StartResponse, 1)})
+	te, ok := err.(*TaskError)
+	if !ok {
+		t.Fatalf("expected a *TaskError, got %T: %v", err, err)
+	}
+	if te.TaskType != "addProcess" || te.ID != "missing" || te.Err != ErrContainerNotFound {
+		t.Fatalf("expected an addProcess error for missing, got %+v", te)
+	}
+}
+
+func TestAddProcessRuntimeArgs(t *testing.T) {
+	execErr := errors.New("exec failed")
+	c := &fakeContainer{id: "c1", execErr: execErr}
+	s := newTestSupervisor(c)
+
+	runtimeArgs := []string{"--systemd-cgroup"}
+	task := &AddProcessTask{
+		ID:            "c1",
+		PID:           "exec1",
+		ProcessSpec:   &specs.ProcessSpec{},
+		RuntimeArgs:   runtimeArgs,
+		StartResponse: make(chan StartResponse, 1),
+	}
+	if err := s.addProcess(task); taskErrorCause(err) != execErr {
+		t.Fatalf("expected %v, got %v", execErr, err)
+	}
+	if !reflect.DeepEqual(c.execRuntimeArgs, runtimeArgs) {
+		t.Fatalf("expected the process to be exec'd with %v, got %v", runtimeArgs, c.execRuntimeArgs)
+	}
+}
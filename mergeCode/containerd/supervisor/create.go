@@ -1,12 +1,12 @@
 package supervisor
 
 import (
+	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
-    "os"
-    "log"
-
 	"github.com/docker/containerd/runtime"
 	"golang.org/x/net/context"
 )
@@ -21,7 +21,9 @@ type StartTask struct {
 	Stdin         string
 	StartResponse chan StartResponse
 	Labels        []string
-	NoPivotRoot   bool
+	// NoPivotRoot disables pivot_root for the container. When nil it is
+	// disabled if the bundle is on a ramdisk, where pivot_root fails.
+	NoPivotRoot   *bool
 	Checkpoint    *runtime.Checkpoint
 	CheckpointDir string
 	Runtime       string
@@ -29,14 +31,35 @@ type StartTask struct {
 	Ctx           context.Context
 }
 
-func (s *Supervisor) start(t *StartTask) error {
+func (s *Supervisor) start(t *StartTask) (err error) {
+	defer func() {
+		err = newTaskError("start", t.ID, err)
+	}()
 	start := time.Now()
+	if err := validateLabels(t.Labels); err != nil {
+		return err
+	}
+	noPivotRoot, err := noPivotRoot(t)
+	if err != nil {
+		return err
+	}
+	checkpointPath, err := checkpointPath(t)
+	if err != nil {
+		return err
+	}
 	rt := s.runtime
 	rtArgs := s.runtimeArgs
 	if t.Runtime != "" {
 		rt = t.Runtime
 		rtArgs = t.RuntimeArgs
 	}
+	// The state dir is checked at startup, check it again for the first
+	// container in case it has changed since.
+	if len(s.containers) == 0 {
+		if err := s.validateStateDir(); err != nil {
+			return err
+		}
+	}
 	container, err := runtime.New(runtime.ContainerOpts{
 		Root:        s.stateDir,
 		ID:          t.ID,
@@ -45,7 +68,7 @@ func (s *Supervisor) start(t *StartTask) error {
 		RuntimeArgs: rtArgs,
 		Shim:        s.shim,
 		Labels:      t.Labels,
-		NoPivotRoot: t.NoPivotRoot,
+		NoPivotRoot: noPivotRoot,
 		Timeout:     s.timeout,
 	})
 	if err != nil {
@@ -54,19 +77,22 @@ func (s *Supervisor) start(t *StartTask) error {
 	s.containers[t.ID] = &containerInfo{
 		container: container,
 	}
-    logPrintCreate("create")
+	logPrintCreate(fmt.Sprintf("create id=%s", t.ID))
 	ContainersCounter.Inc(1)
+	s.notifySubscribers(Event{
+		Timestamp: time.Now(),
+		ID:        t.ID,
+		Type:      StateCreate,
+	})
 	task := &startTask{
-		Err:           t.ErrorCh(),
-		Container:     container,
-		StartResponse: t.StartResponse,
-		Stdin:         t.Stdin,
-		Stdout:        t.Stdout,
-		Stderr:        t.Stderr,
-		Ctx:           t.Ctx,
-	}
-	if t.Checkpoint != nil {
-		task.CheckpointPath = filepath.Join(t.CheckpointDir, t.Checkpoint.Name)
+		Err:            t.ErrorCh(),
+		Container:      container,
+		StartResponse:  t.StartResponse,
+		Stdin:          t.Stdin,
+		Stdout:         t.Stdout,
+		Stderr:         t.Stderr,
+		CheckpointPath: checkpointPath,
+		Ctx:            t.Ctx,
 	}
 
 	s.startTasks <- task
@@ -74,14 +100,45 @@ func (s *Supervisor) start(t *StartTask) error {
 	return errDeferredResponse
 }
 
+// noPivotRoot returns whether pivot_root is disabled for the container,
+// detecting it from the bundle's filesystem if the task leaves it unset.
+func noPivotRoot(t *StartTask) (bool, error) {
+	if t.NoPivotRoot != nil {
+		return *t.NoPivotRoot, nil
+	}
+	ramdisk, err := onRamdisk(t.BundlePath)
+	if err != nil {
+		return false, fmt.Errorf("containerd: detecting the filesystem of bundle %s: %v", t.BundlePath, err)
+	}
+	return ramdisk, nil
+}
 
-func logPrintCreate(errStr string) {
-    logFile, logError := os.OpenFile("/home/vagrant/createlogServer.md", os.O_RDWR|os.O_APPEND, 0666)
-    if logError != nil {
-        logFile, _ = os.Create("/home/vagrant/createlogServer.md")
-    }
-    defer logFile.Close()
+// checkpointPath returns the path of the checkpoint the container is restored
+// from, if any, after checking that it exists.
+func checkpointPath(t *StartTask) (string, error) {
+	if t.Checkpoint == nil {
+		return "", nil
+	}
+	p := filepath.Join(t.CheckpointDir, t.Checkpoint.Name)
+	if _, err := os.Stat(p); err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("containerd: checkpoint not found: %s", p)
+		}
+		return "", fmt.Errorf("containerd: checkpoint %s: %v", p, err)
+	}
+	return p, nil
+}
+
+// validateLabels checks that every label is in the key=value form.
+func validateLabels(labels []string) error {
+	for _, l := range labels {
+		if i := strings.Index(l, "="); i <= 0 {
+			return fmt.Errorf("containerd: invalid label %q, expected key=value", l)
+		}
+	}
+	return nil
+}
 
-    debugLog := log.New(logFile, "[Debug]", log.Llongfile)
-    debugLog.Println(errStr)
+func logPrintCreate(errStr string) {
+	logPrintTo(filepath.Join(debugLogDir, "createlogServer.md"), errStr)
 }
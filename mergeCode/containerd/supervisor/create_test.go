@@ -0,0 +1,119 @@
+package supervisor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/docker/containerd/runtime"
+)
+
+func TestValidateLabels(t *testing.T) {
+	if err := validateLabels([]string{"com.docker.extbuild=1", "step=", "a=b=c"}); err != nil {
+		t.Fatalf("expected labels to be valid, got %v", err)
+	}
+	for _, l := range []string{"novalue", "=value", ""} {
+		err := validateLabels([]string{"valid=1", l})
+		if err == nil {
+			t.Fatalf("expected label %q to be rejected", l)
+		}
+		if !strings.Contains(err.Error(), "invalid label") {
+			t.Fatalf("expected an invalid label error, got %v", err)
+		}
+	}
+}
+
+func TestStartInvalidLabel(t *testing.T) {
+	s := newTestSupervisor()
+	count := ContainersCounter.Count()
+
+	err := s.start(&StartTask{ID: "c1", Labels: []string{"valid=1", "malformed"}})
+	if err == nil || !strings.Contains(err.Error(), `invalid label "malformed"`) {
+		t.Fatalf("expected the malformed label to be reported, got %v", err)
+	}
+	if _, ok := s.containers["c1"]; ok {
+		t.Fatal("expected no container to be registered")
+	}
+	if c := ContainersCounter.Count(); c != count {
+		t.Fatalf("expected the containers counter to stay at %d, got %d", count, c)
+	}
+}
+
+func TestCheckpointPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "step1"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := checkpointPath(&StartTask{CheckpointDir: dir, Checkpoint: &runtime.Checkpoint{Name: "step1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != filepath.Join(dir, "step1") {
+		t.Fatalf("expected checkpoint path %s, got %s", filepath.Join(dir, "step1"), p)
+	}
+
+	if p, err := checkpointPath(&StartTask{}); err != nil || p != "" {
+		t.Fatalf("expected no checkpoint path without a checkpoint, got %q, %v", p, err)
+	}
+}
+
+func TestStartMissingCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := newTestSupervisor()
+	no := false
+	err = s.start(&StartTask{
+		ID:            "c1",
+		NoPivotRoot:   &no,
+		CheckpointDir: dir,
+		Checkpoint:    &runtime.Checkpoint{Name: "missing"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "checkpoint not found") {
+		t.Fatalf("expected a checkpoint not found error, got %v", err)
+	}
+	if _, ok := s.containers["c1"]; ok {
+		t.Fatal("expected no container to be registered")
+	}
+}
+
+func TestStartNotifiesCreate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := newTestSupervisor()
+	s.stateDir = dir
+	s.startTasks = make(chan *startTask, 1)
+	s.subscribers = make(map[chan Event]struct{})
+	events := make(chan Event, 1)
+	s.subscribers[events] = struct{}{}
+
+	no := false
+	if err := s.start(&StartTask{ID: "c1", BundlePath: dir, NoPivotRoot: &no}); err != errDeferredResponse {
+		t.Fatalf("expected a deferred response, got %v", err)
+	}
+	select {
+	case e := <-events:
+		if e.Type != StateCreate || e.ID != "c1" || e.Timestamp.IsZero() {
+			t.Fatalf("expected a %s event for c1, got %+v", StateCreate, e)
+		}
+	default:
+		t.Fatal("expected subscribers to be notified of the created container")
+	}
+	if task := <-s.startTasks; task.Container.ID() != "c1" {
+		t.Fatalf("expected a start task for c1, got %s", task.Container.ID())
+	}
+}
@@ -18,34 +18,36 @@ type DeleteTask struct {
 }
 
 func (s *Supervisor) delete(t *DeleteTask) error {
-	if i, ok := s.containers[t.ID]; ok {
-		start := time.Now()
-		if err := s.deleteContainer(i.container); err != nil {
-			logrus.WithField("error", err).Error("containerd: deleting container")
-		}
-		if t.Process != nil {
-			t.Process.Wait()
-		}
-		if !t.NoEvent {
-			execMap := s.getDeleteExecSyncMap(t.ID)
-			go func() {
-				// Wait for all exec processe events to be sent (we seem
-				// to sometimes receive them after the init event)
-				for _, ch := range execMap {
-					<-ch
-				}
-				s.notifySubscribers(Event{
-					Type:      StateExit,
-					Timestamp: time.Now(),
-					ID:        t.ID,
-					Status:    t.Status,
-					PID:       t.PID,
-				})
-			}()
-		}
-		ContainersCounter.Dec(1)
-		ContainerDeleteTimer.UpdateSince(start)
+	i, ok := s.containers[t.ID]
+	if !ok {
+		return ErrContainerNotFound
 	}
+	start := time.Now()
+	if err := s.deleteContainer(i.container); err != nil {
+		logrus.WithField("error", err).Error("containerd: deleting container")
+	}
+	if t.Process != nil {
+		t.Process.Wait()
+	}
+	if !t.NoEvent {
+		execMap := s.getDeleteExecSyncMap(t.ID)
+		go func() {
+			// Wait for all exec processe events to be sent (we seem
+			// to sometimes receive them after the init event)
+			for _, ch := range execMap {
+				<-ch
+			}
+			s.notifySubscribers(Event{
+				Type:      StateExit,
+				Timestamp: time.Now(),
+				ID:        t.ID,
+				Status:    t.Status,
+				PID:       t.PID,
+			})
+		}()
+	}
+	ContainersCounter.Dec(1)
+	ContainerDeleteTimer.UpdateSince(start)
 	return nil
 }
 
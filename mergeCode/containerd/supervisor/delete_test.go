@@ -0,0 +1,26 @@
+package supervisor
+
+import "testing"
+
+func TestDelete(t *testing.T) {
+	s := newTestSupervisor(&fakeContainer{id: "c1"})
+	ContainersCounter.Inc(1)
+	count := ContainersCounter.Count()
+
+	if err := s.delete(&DeleteTask{ID: "c1", NoEvent: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.containers["c1"]; ok {
+		t.Fatal("expected the container to be removed")
+	}
+	if c := ContainersCounter.Count(); c != count-1 {
+		t.Fatalf("expected the containers counter to be decremented to %d, got %d", count-1, c)
+	}
+
+	if err := s.delete(&DeleteTask{ID: "c1", NoEvent: true}); err != ErrContainerNotFound {
+		t.Fatalf("expected %v for a removed container, got %v", ErrContainerNotFound, err)
+	}
+	if c := ContainersCounter.Count(); c != count-1 {
+		t.Fatalf("expected the containers counter to stay at %d, got %d", count-1, c)
+	}
+}
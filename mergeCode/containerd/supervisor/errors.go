@@ -1,6 +1,9 @@
 package supervisor
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrContainerNotFound is returned when the container ID passed
@@ -15,6 +18,9 @@ var (
 	// ErrUnknownTask is returned when an unknown Task type is
 	// scheduled (should never happen).
 	ErrUnknownTask = errors.New("containerd: unknown task type")
+	// ErrStatsTimeout is returned when a container statistics could not
+	// be retrieved in time.
+	ErrStatsTimeout = errors.New("containerd: timeout retrieving container stats")
 
 	// Internal errors
 	errShutdown          = errors.New("containerd: supervisor is shutdown")
@@ -26,3 +32,30 @@ var (
 	// less like magic
 	errDeferredResponse = errors.New("containerd: deferred response")
 )
+
+// TaskError is returned for a failed task, recording the type of the task
+// and the ID of its container. Err is the cause, e.g. ErrContainerNotFound.
+type TaskError struct {
+	TaskType string
+	ID       string
+	Err      error
+}
+
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("%s %s: %v", e.TaskType, e.ID, e.Err)
+}
+
+// Unwrap returns the cause of the task failure. It is only used by
+// errors.Is and errors.As, which need Go 1.13 or later.
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+// newTaskError wraps err in a TaskError. nil and errDeferredResponse are
+// returned unchanged as they are not failures.
+func newTaskError(taskType, id string, err error) error {
+	if err == nil || err == errDeferredResponse {
+		return err
+	}
+	return &TaskError{TaskType: taskType, ID: id, Err: err}
+}
@@ -1,9 +1,8 @@
 package supervisor
 
 import (
-
-   "os"
-   "log"
+	"fmt"
+	"path/filepath"
 
    "github.com/docker/containerd/runtime"
 )
@@ -24,7 +23,7 @@ func (s *Supervisor) getContainers(t *GetContainersTask) error {
 	if t.ID != "" {
 		ci, ok := s.containers[t.ID]
 		if !ok {
-            logPrintServerGetContainers("getContainers")
+			logPrintServerGetContainers(fmt.Sprintf("getContainers id=%s error=%v", t.ID, ErrContainerNotFound))
 			return ErrContainerNotFound
 		}
 		t.Containers = append(t.Containers, ci.container)
@@ -55,12 +54,5 @@ func (s *Supervisor) getContainers(t *GetContainersTask) error {
 
 
 func logPrintServerGetContainers(errStr string) {
-    logFile, logError := os.Open("/home/vagrant/getlogServer.md")
-    if logError != nil {
-        logFile, _ = os.Create("/home/vagrant/getlogServer.md")
-    }
-    defer logFile.Close()
-
-    debugLog := log.New(logFile, "[Debug]", log.Llongfile)
-    debugLog.Println(errStr)
+	logPrintTo(filepath.Join(debugLogDir, "getlogServer.md"), errStr)
 }
@@ -0,0 +1,24 @@
+package supervisor
+
+import (
+	"log"
+	"os"
+)
+
+// debugLogDir is the directory the debug logs of the task handlers are
+// written to.
+var debugLogDir = "/home/vagrant"
+
+// logPrintTo appends errStr to the debug log at path, creating it if needed.
+// The logged location is the one of the task handler calling the logPrint
+// function of its file.
+func logPrintTo(path, errStr string) {
+	logFile, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0666)
+	if err != nil {
+		return
+	}
+	defer logFile.Close()
+
+	debugLog := log.New(logFile, "[Debug]", log.Llongfile)
+	debugLog.Output(3, errStr)
+}
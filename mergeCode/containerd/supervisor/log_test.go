@@ -0,0 +1,54 @@
+package supervisor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLogPrintToAppends(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	path := filepath.Join(tmpDir, "debug.md")
+	logPrintTo(path, "first")
+	logPrintTo(path, "second")
+
+	dt, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(dt), "first") || !strings.Contains(string(dt), "second") {
+		t.Fatalf("expected both writes in the log, got %q", dt)
+	}
+}
+
+func TestLogPrintIncludesContainerID(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+	defer func(dir string) { debugLogDir = dir }(debugLogDir)
+	debugLogDir = tmpDir
+
+	s := newTestSupervisor()
+	if err := s.signal(&SignalTask{ID: "build-step-1"}); err != ErrContainerNotFound {
+		t.Fatalf("expected %v, got %v", ErrContainerNotFound, err)
+	}
+
+	dt, err := ioutil.ReadFile(filepath.Join(tmpDir, "signallogServer.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"id=build-step-1", ErrContainerNotFound.Error(), "signal.go"} {
+		if !strings.Contains(string(dt), s) {
+			t.Fatalf("expected %q in the log, got %q", s, dt)
+		}
+	}
+}
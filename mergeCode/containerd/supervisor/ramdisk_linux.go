@@ -0,0 +1,24 @@
+package supervisor
+
+import "syscall"
+
+const (
+	tmpfsMagic = 0x01021994
+	ramfsMagic = 0x858458f6
+)
+
+// statfs is replaced in tests.
+var statfs = syscall.Statfs
+
+// onRamdisk returns whether path is on a tmpfs or ramfs filesystem.
+func onRamdisk(path string) (bool, error) {
+	var st syscall.Statfs_t
+	if err := statfs(path, &st); err != nil {
+		return false, err
+	}
+	switch int64(st.Type) {
+	case tmpfsMagic, ramfsMagic:
+		return true, nil
+	}
+	return false, nil
+}
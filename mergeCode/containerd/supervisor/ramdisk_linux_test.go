@@ -0,0 +1,39 @@
+package supervisor
+
+import (
+	"syscall"
+	"testing"
+)
+
+func fakeStatfs(fsType int64) func(string, *syscall.Statfs_t) error {
+	return func(path string, st *syscall.Statfs_t) error {
+		st.Type = fsType
+		return nil
+	}
+}
+
+func TestNoPivotRootDetection(t *testing.T) {
+	defer func(f func(string, *syscall.Statfs_t) error) { statfs = f }(statfs)
+
+	no, yes := false, true
+	for _, tc := range []struct {
+		fsType   int64
+		explicit *bool
+		expected bool
+	}{
+		{fsType: tmpfsMagic, expected: true},
+		{fsType: ramfsMagic, expected: true},
+		{fsType: 0xEF53, expected: false}, // ext4
+		{fsType: tmpfsMagic, explicit: &no, expected: false},
+		{fsType: 0xEF53, explicit: &yes, expected: true},
+	} {
+		statfs = fakeStatfs(tc.fsType)
+		v, err := noPivotRoot(&StartTask{BundlePath: "/bundle", NoPivotRoot: tc.explicit})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != tc.expected {
+			t.Fatalf("expected NoPivotRoot %v for filesystem %#x with explicit value %v, got %v", tc.expected, tc.fsType, tc.explicit, v)
+		}
+	}
+}
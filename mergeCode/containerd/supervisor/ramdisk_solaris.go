@@ -0,0 +1,7 @@
+package supervisor
+
+// onRamdisk is not supported on Solaris, pivot_root is never disabled
+// automatically.
+func onRamdisk(path string) (bool, error) {
+	return false, nil
+}
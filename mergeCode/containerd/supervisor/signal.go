@@ -1,9 +1,9 @@
 package supervisor
 
 import (
+	"fmt"
 	"os"
-
-    "log"
+	"path/filepath"
 )
 
 // SignalTask holds needed parameters to signal a container
@@ -17,7 +17,7 @@ type SignalTask struct {
 func (s *Supervisor) signal(t *SignalTask) error {
 	i, ok := s.containers[t.ID]
 	if !ok {
-        logPrintServerSignal("signal")
+		logPrintServerSignal(fmt.Sprintf("signal id=%s error=%v", t.ID, ErrContainerNotFound))
 		return ErrContainerNotFound
 	}
 	processes, err := i.container.Processes()
@@ -34,12 +34,5 @@ func (s *Supervisor) signal(t *SignalTask) error {
 
 
 func logPrintServerSignal(errStr string) {
-    logFile, logError := os.Open("/home/vagrant/signallogServer.md")
-    if logError != nil {
-        logFile, _ = os.Create("/home/vagrant/signallogServer.md")
-    }
-    defer logFile.Close()
-
-    debugLog := log.New(logFile, "[Debug]", log.Llongfile)
-    debugLog.Println(errStr)
+	logPrintTo(filepath.Join(debugLogDir, "signallogServer.md"), errStr)
 }
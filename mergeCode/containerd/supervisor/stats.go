@@ -1,50 +1,120 @@
 package supervisor
 
 import (
+	"fmt"
+	"path/filepath"
 	"time"
 
-    "os"
-    "log"
-
+	"github.com/Sirupsen/logrus"
 	"github.com/docker/containerd/runtime"
 )
 
+// defaultStatsTimeout is used for a StatsTask without a Timeout.
+const defaultStatsTimeout = 10 * time.Second
+
+// defaultStreamStatsInterval is used for a StreamStatsTask without an Interval.
+const defaultStreamStatsInterval = time.Second
+
 // StatsTask holds needed parameters to retrieve a container statistics
 type StatsTask struct {
 	baseTask
 	ID   string
 	Stat chan *runtime.Stat
+	// Timeout bounds how long retrieving the statistics may take.
+	Timeout time.Duration
 }
 
 func (s *Supervisor) stats(t *StatsTask) error {
 	start := time.Now()
 	i, ok := s.containers[t.ID]
 	if !ok {
-        logPrintServeriStats("stats")
-		return ErrContainerNotFound
+		logPrintServeriStats(fmt.Sprintf("stats id=%s error=%v", t.ID, ErrContainerNotFound))
+		return newTaskError("stats", t.ID, ErrContainerNotFound)
+	}
+	timeout := t.Timeout
+	if timeout <= 0 {
+		timeout = defaultStatsTimeout
 	}
 	// TODO: use workers for this
 	go func() {
-		s, err := i.container.Stats()
-		if err != nil {
-			t.ErrorCh() <- err
-			return
+		type result struct {
+			stat *runtime.Stat
+			err  error
+		}
+		// buffered so that a late Stats call does not leak its goroutine
+		res := make(chan result, 1)
+		go func() {
+			s, err := i.container.Stats()
+			res <- result{s, err}
+		}()
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case r := <-res:
+			if r.err != nil {
+				t.ErrorCh() <- newTaskError("stats", t.ID, r.err)
+				return
+			}
+			t.ErrorCh() <- nil
+			t.Stat <- r.stat
+			ContainerStatsTimer.UpdateSince(start)
+		case <-timer.C:
+			logrus.WithField("id", t.ID).Warn("containerd: timeout retrieving stats")
+			t.ErrorCh() <- newTaskError("stats", t.ID, ErrStatsTimeout)
 		}
-		t.ErrorCh() <- nil
-		t.Stat <- s
-		ContainerStatsTimer.UpdateSince(start)
 	}()
 	return errDeferredResponse
 }
 
+// StreamStatsTask holds needed parameters to periodically retrieve a
+// container statistics. Stat is closed once Stop is closed or the stats can
+// no longer be retrieved, e.g. because the container exited.
+type StreamStatsTask struct {
+	baseTask
+	ID       string
+	Interval time.Duration
+	Stop     chan struct{}
+	Stat     chan *runtime.Stat
+}
+
+func (s *Supervisor) streamStats(t *StreamStatsTask) error {
+	i, ok := s.containers[t.ID]
+	if !ok {
+		return newTaskError("streamStats", t.ID, ErrContainerNotFound)
+	}
+	interval := t.Interval
+	if interval <= 0 {
+		interval = defaultStreamStatsInterval
+	}
+	go func() {
+		defer close(t.Stat)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-t.Stop:
+				return
+			case <-ticker.C:
+			}
+			start := time.Now()
+			st, err := i.container.Stats()
+			if err != nil {
+				if err != runtime.ErrContainerExited {
+					logrus.WithFields(logrus.Fields{"id": t.ID, "error": err}).Warn("containerd: streaming stats")
+				}
+				return
+			}
+			ContainerStatsTimer.UpdateSince(start)
+			select {
+			case t.Stat <- st:
+			case <-t.Stop:
+				return
+			}
+		}
+	}()
+	return nil
+}
 
 func logPrintServeriStats(errStr string) {
-    logFile, logError := os.Open("/home/vagrant/statslogServer.md")
-    if logError != nil {
-        logFile, _ = os.Create("/home/vagrant/statslogServer.md")
-    }
-    defer logFile.Close()
-
-    debugLog := log.New(logFile, "[Debug]", log.Llongfile)
-    debugLog.Println(errStr)
+	logPrintTo(filepath.Join(debugLogDir, "statslogServer.md"), errStr)
 }
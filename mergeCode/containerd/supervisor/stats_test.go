@@ -0,0 +1,140 @@
+package supervisor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/containerd/runtime"
+)
+
+func TestStreamStats(t *testing.T) {
+	s := newTestSupervisor(&fakeContainer{id: "c1"})
+
+	task := &StreamStatsTask{
+		ID:       "c1",
+		Interval: 10 * time.Millisecond,
+		Stop:     make(chan struct{}),
+		Stat:     make(chan *runtime.Stat),
+	}
+	if err := s.streamStats(task); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		select {
+		case st := <-task.Stat:
+			if st == nil {
+				t.Fatal("expected a stat")
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for stat %d", i)
+		}
+	}
+
+	close(task.Stop)
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case _, ok := <-task.Stat:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("expected the stat channel to be closed after stopping")
+		}
+	}
+}
+
+func TestStreamStatsContainerExited(t *testing.T) {
+	s := newTestSupervisor(&fakeContainer{id: "c1", statsErr: runtime.ErrContainerExited})
+
+	task := &StreamStatsTask{
+		ID:       "c1",
+		Interval: 10 * time.Millisecond,
+		Stop:     make(chan struct{}),
+		Stat:     make(chan *runtime.Stat),
+	}
+	if err := s.streamStats(task); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case _, ok := <-task.Stat:
+		if ok {
+			t.Fatal("expected no stats for an exited container")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the stat channel to be closed")
+	}
+}
+
+func TestStreamStatsNotFound(t *testing.T) {
+	s := newTestSupervisor()
+	task := &StreamStatsTask{ID: "missing", Interval: time.Second}
+	err := s.streamStats(task)
+	te, ok := err.(*TaskError)
+	if !ok {
+		t.Fatalf("expected a *TaskError, got %T: %v", err, err)
+	}
+	if te.TaskType != "streamStats" || te.ID != "missing" || te.Err != ErrContainerNotFound {
+		t.Fatalf("unexpected task error %+v", te)
+	}
+}
+
+func TestStreamStatsDefaultInterval(t *testing.T) {
+	s := newTestSupervisor(&fakeContainer{id: "c1"})
+
+	task := &StreamStatsTask{
+		ID:   "c1",
+		Stop: make(chan struct{}),
+		Stat: make(chan *runtime.Stat),
+	}
+	if err := s.streamStats(task); err != nil {
+		t.Fatal(err)
+	}
+	defer close(task.Stop)
+	select {
+	case st := <-task.Stat:
+		if st == nil {
+			t.Fatal("expected a stat")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for a stat")
+	}
+}
+
+func TestStatsTimeout(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	s := newTestSupervisor(&fakeContainer{id: "c1", statsBlock: block})
+
+	task := &StatsTask{
+		ID:      "c1",
+		Stat:    make(chan *runtime.Stat, 1),
+		Timeout: 50 * time.Millisecond,
+	}
+	if err := s.stats(task); err != errDeferredResponse {
+		t.Fatalf("expected a deferred response, got %v", err)
+	}
+	select {
+	case err := <-task.ErrorCh():
+		if taskErrorCause(err) != ErrStatsTimeout {
+			t.Fatalf("expected %v, got %v", ErrStatsTimeout, err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the stats timeout to be reported")
+	}
+}
+
+func TestStats(t *testing.T) {
+	s := newTestSupervisor(&fakeContainer{id: "c1"})
+
+	task := &StatsTask{ID: "c1", Stat: make(chan *runtime.Stat, 1)}
+	if err := s.stats(task); err != errDeferredResponse {
+		t.Fatalf("expected a deferred response, got %v", err)
+	}
+	if err := <-task.ErrorCh(); err != nil {
+		t.Fatal(err)
+	}
+	if st := <-task.Stat; st == nil {
+		t.Fatal("expected a stat")
+	}
+}
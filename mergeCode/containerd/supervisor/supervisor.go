@@ -2,6 +2,7 @@ package supervisor
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
@@ -9,8 +10,6 @@ import (
 	"sync"
 	"time"
 
-    "log"
-
 	"github.com/Sirupsen/logrus"
 	"github.com/docker/containerd/runtime"
 )
@@ -25,6 +24,9 @@ func New(stateDir string, runtimeName, shimName string, runtimeArgs []string, ti
 	if err := os.MkdirAll(stateDir, 0755); err != nil {
 		return nil, err
 	}
+	if err := (&Supervisor{stateDir: stateDir}).validateStateDir(); err != nil {
+		return nil, err
+	}
 	machine, err := CollectMachineInformation()
 	if err != nil {
 		return nil, err
@@ -58,6 +60,24 @@ func New(stateDir string, runtimeName, shimName string, runtimeArgs []string, ti
 	return s, nil
 }
 
+// validateStateDir checks that the state directory exists and is writable, so
+// that a misconfigured host fails at startup rather than for every container.
+func (s *Supervisor) validateStateDir() error {
+	fi, err := os.Stat(s.stateDir)
+	if err != nil {
+		return fmt.Errorf("containerd: state dir: %v", err)
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("containerd: state dir %s is not a directory", s.stateDir)
+	}
+	f, err := ioutil.TempFile(s.stateDir, ".validate")
+	if err != nil {
+		return fmt.Errorf("containerd: state dir %s is not writable: %v", s.stateDir, err)
+	}
+	f.Close()
+	return os.Remove(f.Name())
+}
+
 type containerInfo struct {
 	container runtime.Container
 }
@@ -344,7 +364,7 @@ func (s *Supervisor) restore() error {
 		s.containers[id] = &containerInfo{
 			container: container,
 		}
-        logPrintSupervisor("supervisor")
+		logPrintSupervisor(fmt.Sprintf("restore id=%s", id))
 		if err := s.monitor.MonitorOOM(container); err != nil && err != runtime.ErrContainerExited {
 			logrus.WithField("error", err).Error("containerd: notify OOM events")
 		}
@@ -398,6 +418,8 @@ func (s *Supervisor) handleTask(i Task) {
 		err = s.signal(t)
 	case *StatsTask:
 		err = s.stats(t)
+	case *StreamStatsTask:
+		err = s.streamStats(t)
 	case *UpdateTask:
 		err = s.updateContainer(t)
 	case *UpdateProcessTask:
@@ -442,12 +464,5 @@ func (s *Supervisor) getDeleteExecSyncMap(containerID string) map[string]chan st
 
 
 func logPrintSupervisor(errStr string) {
-    logFile, logError := os.Open("/home/vagrant/supervisorlogServer.md")
-    if logError != nil {
-        logFile, _ = os.Create("/home/vagrant/supervisorlogServer.md")
-    }
-    defer logFile.Close()
-
-    debugLog := log.New(logFile, "[Debug]", log.Llongfile)
-    debugLog.Println(errStr)
+	logPrintTo(filepath.Join(debugLogDir, "supervisorlogServer.md"), errStr)
 }
@@ -5,12 +5,79 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/docker/containerd/runtime"
+	"github.com/docker/containerd/specs"
+	"golang.org/x/net/context"
 )
 
+// fakeContainer implements the parts of runtime.Container used by the
+// supervisor tests.
+type fakeContainer struct {
+	runtime.Container
+	id string
+
+	mu    sync.Mutex
+	stats int
+	// statsErr is returned by Stats once set.
+	statsErr error
+	// execErr is returned by Exec.
+	execErr error
+	// execRuntimeArgs records the runtime args Exec was called with.
+	execRuntimeArgs []string
+	// statsBlock makes Stats block until it is closed.
+	statsBlock chan struct{}
+}
+
+func (c *fakeContainer) ID() string {
+	return c.id
+}
+
+func (c *fakeContainer) Stats() (*runtime.Stat, error) {
+	if c.statsBlock != nil {
+		<-c.statsBlock
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.statsErr != nil {
+		return nil, c.statsErr
+	}
+	c.stats++
+	return &runtime.Stat{Timestamp: time.Now()}, nil
+}
+
+func (c *fakeContainer) Exec(ctx context.Context, pid string, spec specs.ProcessSpec, runtimeArgs []string, stdio runtime.Stdio) (runtime.Process, error) {
+	c.mu.Lock()
+	c.execRuntimeArgs = runtimeArgs
+	c.mu.Unlock()
+	return nil, c.execErr
+}
+
+func (c *fakeContainer) Delete() error {
+	return nil
+}
+
+func newTestSupervisor(containers ...runtime.Container) *Supervisor {
+	s := &Supervisor{containers: make(map[string]*containerInfo)}
+	for _, c := range containers {
+		s.containers[c.ID()] = &containerInfo{container: c}
+	}
+	return s
+}
+
+// taskErrorCause returns the cause of err if it is a *TaskError, err itself
+// otherwise.
+func taskErrorCause(err error) error {
+	if te, ok := err.(*TaskError); ok {
+		return te.Err
+	}
+	return err
+}
+
 func TestEventLogCompat(t *testing.T) {
 	tmpDir, err := ioutil.TempDir("", "")
 	if err != nil {
@@ -63,3 +130,50 @@ func TestEventLogCompat(t *testing.T) {
 		t.Errorf("Improper event status: %v", s.eventLog[1].Status)
 	}
 }
+
+func TestValidateStateDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "containerd-state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := newTestSupervisor()
+	s.stateDir = dir
+	if err := s.validateStateDir(); err != nil {
+		t.Fatal(err)
+	}
+	if entries, _ := ioutil.ReadDir(dir); len(entries) != 0 {
+		t.Fatalf("expected the validation to leave no file behind, got %d", len(entries))
+	}
+
+	s.stateDir = filepath.Join(dir, "missing")
+	if err := s.validateStateDir(); err == nil {
+		t.Fatal("expected an error for a missing state dir")
+	}
+}
+
+func TestValidateStateDirReadOnly(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("root can write to a read-only dir")
+	}
+	dir, err := ioutil.TempDir("", "containerd-state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.Chmod(dir, 0500); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chmod(dir, 0700)
+
+	s := newTestSupervisor()
+	s.stateDir = dir
+	if err := s.validateStateDir(); err == nil || !strings.Contains(err.Error(), "not writable") {
+		t.Fatalf("expected a not writable error, got %v", err)
+	}
+	err = s.start(&StartTask{ID: "c1", BundlePath: dir})
+	if err == nil || !strings.Contains(err.Error(), "not writable") {
+		t.Fatalf("expected start to report the read-only state dir, got %v", err)
+	}
+}
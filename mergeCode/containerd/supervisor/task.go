@@ -10,6 +10,21 @@ import (
 type StartResponse struct {
 	ExecPid   int
 	Container runtime.Container
+	// Err is set when the process could not be started.
+	Err error
+}
+
+// sendStartError unblocks a caller waiting on ch after a start failed. The
+// error is sent if ch has room for it, and ch is closed.
+func sendStartError(ch chan StartResponse, err error) {
+	if ch == nil {
+		return
+	}
+	select {
+	case ch <- StartResponse{Err: err}:
+	default:
+	}
+	close(ch)
 }
 
 // Task executes an action returning an error chan with either nil or
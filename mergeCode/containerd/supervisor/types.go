@@ -2,6 +2,7 @@ package supervisor
 
 // State constants used in Event types
 const (
+	StateCreate       = "create-container"
 	StateStart        = "start-container"
 	StatePause        = "pause"
 	StateResume       = "resume"
@@ -1,11 +1,10 @@
 package supervisor
 
 import (
+	"fmt"
+	"path/filepath"
 	"time"
 
-    "os"
-    "log"
-
 	"github.com/docker/containerd/runtime"
 )
 
@@ -20,7 +19,7 @@ type UpdateTask struct {
 func (s *Supervisor) updateContainer(t *UpdateTask) error {
 	i, ok := s.containers[t.ID]
 	if !ok {
-        logPrintUpdate("updateContainer")
+		logPrintUpdate(fmt.Sprintf("updateContainer id=%s error=%v", t.ID, ErrContainerNotFound))
 		return ErrContainerNotFound
 	}
 	container := i.container
@@ -69,7 +68,7 @@ type UpdateProcessTask struct {
 func (s *Supervisor) updateProcess(t *UpdateProcessTask) error {
 	i, ok := s.containers[t.ID]
 	if !ok {
-        logPrintUpdate("updateProcess")
+		logPrintUpdate(fmt.Sprintf("updateProcess id=%s pid=%s error=%v", t.ID, t.PID, ErrContainerNotFound))
 		return ErrContainerNotFound
 	}
 	processes, err := i.container.Processes()
@@ -101,13 +100,6 @@ func (s *Supervisor) updateProcess(t *UpdateProcessTask) error {
 
 
 func logPrintUpdate(errStr string) {
-    logFile, logError := os.Open("/home/vagrant/updatelogServer.md")
-    if logError != nil {
-        logFile, _ = os.Create("/home/vagrant/updatelogServer.md")
-    }
-    defer logFile.Close()
-
-    debugLog := log.New(logFile, "[Debug]", log.Llongfile)
-    debugLog.Println(errStr)
+	logPrintTo(filepath.Join(debugLogDir, "updatelogServer.md"), errStr)
 }
 
@@ -107,7 +107,7 @@ func (pm *Manager) GetFirstContainerBuildingStatus(id string) bool {
       fmt.Println("plugin/manager.go GetFirstContaineriBuildingStatus() is null!!!")
       return false
 }
-func (pm *Manager) TriggerExitEvent(cId string) error {
+func (pm *Manager) TriggerExitEvent(cId string, exitCode uint32) error {
 
       fmt.Println("plugin/manager.go TriggerExitEvent() is null!!!")
       return nil